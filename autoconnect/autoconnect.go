@@ -0,0 +1,124 @@
+// Package autoconnect connects handler methods to the signals of the widgets
+// of a generated UI struct by name, like Qt's QMetaObject::connectSlotsByName.
+// The code generated with "goqtuic -connect-slots-by-name" uses it, passing
+// the root widget, which is not a field of the UI struct, as an object so
+// that its signals can be handled too, e.g. by OnFormDestroyed.
+//
+// A handler can be any value: which of its methods are handlers, and whether
+// they match a signal, is only known when they are connected, so mistakes are
+// reported as errors at run time rather than by the compiler.
+package autoconnect
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+const handlerPrefix = "On"
+
+// SlotsByName connects every method of handler named On<Field><Signal>, e.g.
+// OnOkButtonClicked, to the Connect<Signal> method of the field <Field> of ui,
+// a pointer to a generated UI struct whose SetupUI has been called. objects
+// maps the names of other objects whose signals can be handled, like the root
+// widget, to them. It returns an error for every handler method that matches
+// no field, object or signal.
+func SlotsByName(ui interface{}, handler interface{}, objects map[string]interface{}) []error {
+	uiValue := reflect.ValueOf(ui)
+	if uiValue.Kind() != reflect.Ptr || uiValue.Elem().Kind() != reflect.Struct {
+		return []error{fmt.Errorf("autoconnect: ui must be a pointer to a struct, not %T", ui)}
+	}
+	uiValue = uiValue.Elem()
+
+	senders := map[string]reflect.Value{}
+	for i := 0; i < uiValue.NumField(); i++ {
+		if f := uiValue.Type().Field(i); f.PkgPath == "" {
+			senders[f.Name] = uiValue.Field(i)
+		}
+	}
+	for name, object := range objects {
+		senders[name] = reflect.ValueOf(object)
+	}
+
+	errs := []error{}
+	handlerValue := reflect.ValueOf(handler)
+	if !handlerValue.IsValid() {
+		return errs
+	}
+	for i := 0; i < handlerValue.NumMethod(); i++ {
+		name := handlerValue.Type().Method(i).Name
+		if !isHandlerName(name) {
+			continue
+		}
+		if err := connect(senders, name, handlerValue.Method(i)); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errs
+}
+
+// ConnectSlotsByName is like SlotsByName but joins the errors into one, nil
+// if every handler method was connected.
+func ConnectSlotsByName(ui interface{}, handler interface{}, objects map[string]interface{}) error {
+	errs := SlotsByName(ui, handler, objects)
+	if len(errs) == 0 {
+		return nil
+	}
+
+	msgs := make([]string, len(errs))
+	for i, err := range errs {
+		msgs[i] = err.Error()
+	}
+	return errors.New(strings.Join(msgs, "\n"))
+}
+
+func isHandlerName(name string) bool {
+	if !strings.HasPrefix(name, handlerPrefix) {
+		return false
+	}
+	r, _ := utf8.DecodeRuneInString(name[len(handlerPrefix):])
+	return unicode.IsUpper(r)
+}
+
+// connect connects the handler method name to the signal of the sender it
+// names. The longest matching sender name wins, so OnOkButtonClicked prefers
+// the field OkButton over Ok.
+func connect(senders map[string]reflect.Value, name string, method reflect.Value) error {
+	rest := name[len(handlerPrefix):]
+
+	var field reflect.Value
+	var fieldName string
+	for senderName, sender := range senders {
+		if len(senderName) >= len(rest) || !strings.HasPrefix(rest, senderName) {
+			continue
+		}
+		if len(senderName) > len(fieldName) {
+			field, fieldName = sender, senderName
+		}
+	}
+	if fieldName == "" {
+		return fmt.Errorf("autoconnect: no widget matches handler %s", name)
+	}
+
+	if !field.IsValid() {
+		return fmt.Errorf("autoconnect: %s is nil, handler %s not connected", fieldName, name)
+	}
+
+	signal := rest[len(fieldName):]
+	connector := field.MethodByName("Connect" + signal)
+	if !connector.IsValid() || connector.Type().NumIn() != 1 || connector.Type().In(0).Kind() != reflect.Func {
+		return fmt.Errorf("autoconnect: %s has no signal %s for handler %s", fieldName, signal, name)
+	}
+	if field.Kind() == reflect.Ptr && field.IsNil() {
+		return fmt.Errorf("autoconnect: %s is nil, handler %s not connected", fieldName, name)
+	}
+	if want := connector.Type().In(0); !method.Type().AssignableTo(want) {
+		return fmt.Errorf("autoconnect: handler %s is %s, signal %s of %s needs %s", name, method.Type(), signal, fieldName, want)
+	}
+
+	connector.Call([]reflect.Value{method})
+	return nil
+}
@@ -0,0 +1,94 @@
+package autoconnect
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+type button struct {
+	clicked func(checked bool)
+}
+
+func (this *button) ConnectClicked(f func(checked bool)) {
+	this.clicked = f
+}
+
+type ui struct {
+	Ok       *button
+	OkButton *button
+	Missing  *button
+}
+
+type handler struct {
+	clicked []string
+}
+
+func (this *handler) OnOkButtonClicked(checked bool) {
+	this.clicked = append(this.clicked, "OkButton")
+}
+
+func (this *handler) OnOkClicked(checked bool) {
+	this.clicked = append(this.clicked, "Ok")
+}
+
+func (this *handler) OnOkPressed()          {}
+func (this *handler) OnCancelClicked()      {}
+func (this *handler) OnMissingClicked(bool) {}
+func (this *handler) OnOkButtonClicked2()   {}
+func (this *handler) Once()                 {}
+
+var _ = Describe("TestSlotsByName", func() {
+	It("test", func() {
+		u := &ui{Ok: &button{}, OkButton: &button{}}
+		h := &handler{}
+
+		errs := SlotsByName(u, h, nil)
+		Expect(errs).To(ConsistOf(
+			MatchError("autoconnect: no widget matches handler OnCancelClicked"),
+			MatchError("autoconnect: Missing is nil, handler OnMissingClicked not connected"),
+			MatchError("autoconnect: Ok has no signal Pressed for handler OnOkPressed"),
+			MatchError("autoconnect: OkButton has no signal Clicked2 for handler OnOkButtonClicked2"),
+		))
+
+		u.OkButton.clicked(true)
+		u.Ok.clicked(false)
+		Expect(h.clicked).To(Equal([]string{"OkButton", "Ok"}))
+	})
+
+	It("checks handler types", func() {
+		u := &ui{Ok: &button{}}
+		errs := SlotsByName(u, &struct{ typeHandler }{}, nil)
+		Expect(errs).To(ConsistOf(MatchError("autoconnect: handler OnOkClicked is func(), signal Clicked of Ok needs func(bool)")))
+	})
+
+	It("returns the errors as one", func() {
+		Expect(ConnectSlotsByName(&ui{Ok: &button{}}, &struct{ typeHandler }{}, nil)).To(
+			MatchError("autoconnect: handler OnOkClicked is func(), signal Clicked of Ok needs func(bool)"))
+		Expect(ConnectSlotsByName(&ui{}, nil, nil)).To(Succeed())
+	})
+
+	It("connects the signals of the objects", func() {
+		form := &button{}
+		h := &formHandler{}
+
+		errs := SlotsByName(&ui{}, h, map[string]interface{}{"Form": form, "Dialog": nil})
+		Expect(errs).To(ConsistOf(MatchError("autoconnect: Dialog is nil, handler OnDialogClicked not connected")))
+
+		form.clicked(true)
+		Expect(h.clicked).To(BeTrue())
+	})
+})
+
+type formHandler struct {
+	clicked bool
+}
+
+func (this *formHandler) OnFormClicked(checked bool) {
+	this.clicked = checked
+}
+
+func (this *formHandler) OnDialogClicked(checked bool) {}
+
+type typeHandler struct{}
+
+func (typeHandler) OnOkClicked() {}
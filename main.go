@@ -4,12 +4,38 @@ import (
 	"flag"
 	"fmt"
 	"github.com/stephenlyu/goqtuic/parser"
+	goparser "go/parser"
+	"go/token"
 	"io/ioutil"
 	"os"
 	"path/filepath"
 	"strings"
 )
 
+// qrcImports collects -qrc-import flags of the form file.qrc=import/path.
+type qrcImports map[string]string
+
+func (this qrcImports) String() string {
+	parts := []string{}
+	for file, _import := range this {
+		parts = append(parts, file+"="+_import)
+	}
+	return strings.Join(parts, ",")
+}
+
+func (this qrcImports) Set(value string) error {
+	i := strings.Index(value, "=")
+	if i <= 0 || i == len(value)-1 {
+		return fmt.Errorf("bad qrc import %s, file.qrc=import/path expected", value)
+	}
+	this[value[:i]] = value[i+1:]
+	return nil
+}
+
+var resourceImports = qrcImports{}
+
+var connectSlotsByName bool
+
 func translateUIFile(uiFile string, destDir string, testGoFile string) error {
 	base := filepath.Base(uiFile)
 	destDir, _ = filepath.Abs(filepath.Clean(destDir))
@@ -38,7 +64,16 @@ func translateUIFile(uiFile string, destDir string, testGoFile string) error {
 		return err
 	}
 
-	compiler.Parse()
+	err = compiler.Parse()
+	if err != nil {
+		return err
+	}
+
+	for qrcFile, _import := range resourceImports {
+		compiler.ResourceImports[qrcFile] = _import
+	}
+	compiler.ConnectSlotsByName = connectSlotsByName
+
 	err = compiler.GenerateCode(packageName, goFile)
 	if err != nil {
 		return err
@@ -66,10 +101,81 @@ func translateUIFile(uiFile string, destDir string, testGoFile string) error {
 	return nil
 }
 
+// goPackageName returns the package of the Go files in dir, or the name of
+// dir if it has none.
+func goPackageName(dir string) string {
+	files, _ := filepath.Glob(filepath.Join(dir, "*.go"))
+	for _, file := range files {
+		if strings.HasSuffix(file, "_test.go") {
+			continue
+		}
+		f, err := goparser.ParseFile(token.NewFileSet(), file, nil, goparser.PackageClauseOnly)
+		if err == nil {
+			return f.Name.Name
+		}
+	}
+	abs, _ := filepath.Abs(dir)
+	return filepath.Base(abs)
+}
+
+func translateQrcFile(qrcFile string, goFile string, packageName string) error {
+	if goFile == "" {
+		goFile = filepath.Join(filepath.Dir(qrcFile), strings.Replace(filepath.Base(qrcFile), ".", "_", -1)+".go")
+	}
+	if packageName == "" {
+		packageName = goPackageName(filepath.Dir(goFile))
+	}
+
+	fmt.Printf("Translating %s...\n", qrcFile)
+
+	err, compiler := parser.NewQrcCompiler(qrcFile)
+	if err != nil {
+		return err
+	}
+	return compiler.GenerateCode(packageName, goFile)
+}
+
+// qrcMain implements "goqtuic qrc", which generates Go files embedding the
+// files of .qrc files and registering them with the Qt resource system.
+func qrcMain(args []string) {
+	flags := flag.NewFlagSet("qrc", flag.ExitOnError)
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(), "Usage: %s qrc [flags] file.qrc...\n", os.Args[0])
+		flags.PrintDefaults()
+	}
+	goFile := flags.String("o", "", "Generated go file, defaults to file_qrc.go next to the qrc file. Only valid with a single qrc file")
+	packageName := flags.String("package", "", "Package of the generated go file, defaults to the package of the go files in its directory")
+	flags.Parse(args)
+
+	if flags.NArg() == 0 || (*goFile != "" && flags.NArg() > 1) {
+		flags.Usage()
+		os.Exit(2)
+	}
+
+	failed := false
+	for _, qrcFile := range flags.Args() {
+		if err := translateQrcFile(qrcFile, *goFile, *packageName); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			failed = true
+		}
+	}
+
+	if failed {
+		os.Exit(1)
+	}
+}
+
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "qrc" {
+		qrcMain(os.Args[2:])
+		return
+	}
+
 	uiFile := flag.String("ui-file", "ui", "QT Designer ui file or directory")
 	uiGoDir := flag.String("go-ui-dir", "uigen", "Generated ui go files directory")
 	testGoFile := flag.String("go-test-file", "", "Test go file path")
+	flag.Var(resourceImports, "qrc-import", "Go package generated for a qrc file, file.qrc=import/path. May be repeated")
+	flag.BoolVar(&connectSlotsByName, "connect-slots-by-name", false, "Make SetupUI take a handler whose On<Widget><Signal> methods are connected to the signals of the widgets, the root widget included, e.g. OnFormDestroyed. The handler is untyped, unmatched methods are returned as an error by SetupUI")
 
 	flag.Parse()
 
@@ -78,6 +184,7 @@ func main() {
 		panic(err)
 	}
 
+	failed := false
 	if stat.IsDir() {
 		files, err := ioutil.ReadDir(*uiFile)
 		if err != nil {
@@ -95,9 +202,19 @@ func main() {
 
 			filePath := filepath.Join(*uiFile, f.Name())
 
-			translateUIFile(filePath, *uiGoDir, "")
+			if err := translateUIFile(filePath, *uiGoDir, ""); err != nil {
+				fmt.Fprintln(os.Stderr, err)
+				failed = true
+			}
 		}
 	} else {
-		translateUIFile(*uiFile, *uiGoDir, *testGoFile)
+		if err := translateUIFile(*uiFile, *uiGoDir, *testGoFile); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			failed = true
+		}
+	}
+
+	if failed {
+		os.Exit(1)
 	}
 }
@@ -0,0 +1,1084 @@
+package parser
+
+// ClassPackages maps the Qt classes of the therecipe binding to their
+// package. It is checked against the binding by TestEnumPackages.
+var ClassPackages = map[string]string{
+	// core
+	"QAbstractAnimation":              "core",
+	"QAbstractConcatenable":           "core",
+	"QAbstractEventDispatcher":        "core",
+	"QAbstractItemModel":              "core",
+	"QAbstractListModel":              "core",
+	"QAbstractNativeEventFilter":      "core",
+	"QAbstractProxyModel":             "core",
+	"QAbstractState":                  "core",
+	"QAbstractTableModel":             "core",
+	"QAbstractTransition":             "core",
+	"QAnimationGroup":                 "core",
+	"QArgument":                       "core",
+	"QArrayData":                      "core",
+	"QArrayDataPointer":               "core",
+	"QAssociativeIterable":            "core",
+	"QAtomicInt":                      "core",
+	"QAtomicInteger":                  "core",
+	"QAtomicOps":                      "core",
+	"QAtomicPointer":                  "core",
+	"QAtomicTraits":                   "core",
+	"QBEInteger":                      "core",
+	"QBasicAtomicInteger":             "core",
+	"QBasicAtomicPointer":             "core",
+	"QBasicMutex":                     "core",
+	"QBasicTimer":                     "core",
+	"QBigEndianStorageType":           "core",
+	"QBitArray":                       "core",
+	"QBuffer":                         "core",
+	"QByteArray":                      "core",
+	"QByteArrayList":                  "core",
+	"QByteArrayMatcher":               "core",
+	"QByteRef":                        "core",
+	"QCache":                          "core",
+	"QCborArray":                      "core",
+	"QCborError":                      "core",
+	"QCborMap":                        "core",
+	"QCborParserError":                "core",
+	"QCborStreamReader":               "core",
+	"QCborStreamWriter":               "core",
+	"QCborValue":                      "core",
+	"QCborValueRef":                   "core",
+	"QChar":                           "core",
+	"QChildEvent":                     "core",
+	"QCollator":                       "core",
+	"QCollatorSortKey":                "core",
+	"QCommandLineOption":              "core",
+	"QCommandLineParser":              "core",
+	"QConcatenateTablesProxyModel":    "core",
+	"QContiguousCache":                "core",
+	"QContiguousCacheData":            "core",
+	"QContiguousCacheTypedData":       "core",
+	"QCoreApplication":                "core",
+	"QCryptographicHash":              "core",
+	"QDataStream":                     "core",
+	"QDate":                           "core",
+	"QDateTime":                       "core",
+	"QDeadlineTimer":                  "core",
+	"QDebug":                          "core",
+	"QDebugStateSaver":                "core",
+	"QDeferredDeleteEvent":            "core",
+	"QDir":                            "core",
+	"QDirIterator":                    "core",
+	"QDynamicPropertyChangeEvent":     "core",
+	"QEasingCurve":                    "core",
+	"QElapsedTimer":                   "core",
+	"QEnableSharedFromThis":           "core",
+	"QEvent":                          "core",
+	"QEventLoop":                      "core",
+	"QEventLoopLocker":                "core",
+	"QEventTransition":                "core",
+	"QException":                      "core",
+	"QExplicitlySharedDataPointer":    "core",
+	"QFactoryInterface":               "core",
+	"QFile":                           "core",
+	"QFileDevice":                     "core",
+	"QFileInfo":                       "core",
+	"QFileSelector":                   "core",
+	"QFileSystemWatcher":              "core",
+	"QFinalState":                     "core",
+	"QFlag":                           "core",
+	"QFlags":                          "core",
+	"QFuture":                         "core",
+	"QFutureInterface":                "core",
+	"QFutureInterfaceBase":            "core",
+	"QFutureIterator":                 "core",
+	"QFutureSynchronizer":             "core",
+	"QFutureWatcher":                  "core",
+	"QFutureWatcherBase":              "core",
+	"QGenericArgument":                "core",
+	"QGenericAtomicOps":               "core",
+	"QGenericReturnArgument":          "core",
+	"QGlobalStatic":                   "core",
+	"QHash":                           "core",
+	"QHashData":                       "core",
+	"QHashIterator":                   "core",
+	"QHashNode":                       "core",
+	"QHistoryState":                   "core",
+	"QIODevice":                       "core",
+	"QIdentityProxyModel":             "core",
+	"QIncompatibleFlag":               "core",
+	"QInternal":                       "core",
+	"QItemSelection":                  "core",
+	"QItemSelectionModel":             "core",
+	"QItemSelectionRange":             "core",
+	"QJsonArray":                      "core",
+	"QJsonDocument":                   "core",
+	"QJsonObject":                     "core",
+	"QJsonParseError":                 "core",
+	"QJsonValue":                      "core",
+	"QJsonValuePtr":                   "core",
+	"QJsonValueRefPtr":                "core",
+	"QKeyValueIterator":               "core",
+	"QLEInteger":                      "core",
+	"QLatin1Char":                     "core",
+	"QLatin1String":                   "core",
+	"QLibrary":                        "core",
+	"QLibraryInfo":                    "core",
+	"QLine":                           "core",
+	"QLineF":                          "core",
+	"QLinkedList":                     "core",
+	"QLinkedListIterator":             "core",
+	"QLinkedListNode":                 "core",
+	"QListData":                       "core",
+	"QListIterator":                   "core",
+	"QListSpecialMethods":             "core",
+	"QLittleEndianStorageType":        "core",
+	"QLocale":                         "core",
+	"QLockFile":                       "core",
+	"QLoggingCategory":                "core",
+	"QMap":                            "core",
+	"QMapData":                        "core",
+	"QMapDataBase":                    "core",
+	"QMapIterator":                    "core",
+	"QMapNode":                        "core",
+	"QMapNodeBase":                    "core",
+	"QMargins":                        "core",
+	"QMarginsF":                       "core",
+	"QMessageAuthenticationCode":      "core",
+	"QMessageLogContext":              "core",
+	"QMessageLogger":                  "core",
+	"QMetaClassInfo":                  "core",
+	"QMetaEnum":                       "core",
+	"QMetaMethod":                     "core",
+	"QMetaObject":                     "core",
+	"QMetaProperty":                   "core",
+	"QMetaType":                       "core",
+	"QMimeData":                       "core",
+	"QMimeDatabase":                   "core",
+	"QMimeType":                       "core",
+	"QModelIndex":                     "core",
+	"QMultiHash":                      "core",
+	"QMultiMap":                       "core",
+	"QMutableHashIterator":            "core",
+	"QMutableLinkedListIterator":      "core",
+	"QMutableListIterator":            "core",
+	"QMutableMapIterator":             "core",
+	"QMutableSetIterator":             "core",
+	"QMutableVectorIterator":          "core",
+	"QMutex":                          "core",
+	"QMutexLocker":                    "core",
+	"QNoDebug":                        "core",
+	"QObject":                         "core",
+	"QObjectCleanupHandler":           "core",
+	"QObjectData":                     "core",
+	"QObjectUserData":                 "core",
+	"QOperatingSystemVersion":         "core",
+	"QPair":                           "core",
+	"QParallelAnimationGroup":         "core",
+	"QPauseAnimation":                 "core",
+	"QPersistentModelIndex":           "core",
+	"QPluginLoader":                   "core",
+	"QPoint":                          "core",
+	"QPointF":                         "core",
+	"QPointer":                        "core",
+	"QProcess":                        "core",
+	"QProcessEnvironment":             "core",
+	"QPropertyAnimation":              "core",
+	"QQueue":                          "core",
+	"QRandomGenerator":                "core",
+	"QRandomGenerator64":              "core",
+	"QReadLocker":                     "core",
+	"QReadWriteLock":                  "core",
+	"QRect":                           "core",
+	"QRectF":                          "core",
+	"QRegExp":                         "core",
+	"QRegularExpression":              "core",
+	"QRegularExpressionMatch":         "core",
+	"QRegularExpressionMatchIterator": "core",
+	"QResource":                       "core",
+	"QReturnArgument":                 "core",
+	"QRunnable":                       "core",
+	"QSaveFile":                       "core",
+	"QScopeGuard":                     "core",
+	"QScopedArrayPointer":             "core",
+	"QScopedPointer":                  "core",
+	"QScopedPointerArrayDeleter":      "core",
+	"QScopedPointerDeleter":           "core",
+	"QScopedPointerObjectDeleteLater": "core",
+	"QScopedPointerPodDeleter":        "core",
+	"QScopedValueRollback":            "core",
+	"QSemaphore":                      "core",
+	"QSemaphoreReleaser":              "core",
+	"QSequentialAnimationGroup":       "core",
+	"QSequentialIterable":             "core",
+	"QSet":                            "core",
+	"QSetIterator":                    "core",
+	"QSettings":                       "core",
+	"QSharedData":                     "core",
+	"QSharedDataPointer":              "core",
+	"QSharedMemory":                   "core",
+	"QSharedPointer":                  "core",
+	"QSignalBlocker":                  "core",
+	"QSignalMapper":                   "core",
+	"QSignalTransition":               "core",
+	"QSize":                           "core",
+	"QSizeF":                          "core",
+	"QSocketNotifier":                 "core",
+	"QSortFilterProxyModel":           "core",
+	"QSpecialInteger":                 "core",
+	"QStack":                          "core",
+	"QStandardPaths":                  "core",
+	"QState":                          "core",
+	"QStateMachine":                   "core",
+	"QStaticByteArrayData":            "core",
+	"QStaticByteArrayMatcher":         "core",
+	"QStaticPlugin":                   "core",
+	"QStaticStringData":               "core",
+	"QStorageInfo":                    "core",
+	"QString":                         "core",
+	"QStringBuilderCommon":            "core",
+	"QStringList":                     "core",
+	"QStringListModel":                "core",
+	"QStringMatcher":                  "core",
+	"QStringRef":                      "core",
+	"QStringView":                     "core",
+	"QSysInfo":                        "core",
+	"QSystemSemaphore":                "core",
+	"QTemporaryDir":                   "core",
+	"QTemporaryFile":                  "core",
+	"QTextBoundaryFinder":             "core",
+	"QTextCodec":                      "core",
+	"QTextDecoder":                    "core",
+	"QTextEncoder":                    "core",
+	"QTextStream":                     "core",
+	"QTextStreamManipulator":          "core",
+	"QThread":                         "core",
+	"QThreadPool":                     "core",
+	"QThreadStorage":                  "core",
+	"QThreadStorageData":              "core",
+	"QTime":                           "core",
+	"QTimeLine":                       "core",
+	"QTimeZone":                       "core",
+	"QTimer":                          "core",
+	"QTimerEvent":                     "core",
+	"QTranslator":                     "core",
+	"QTransposeProxyModel":            "core",
+	"QTypedArrayData":                 "core",
+	"QUnhandledException":             "core",
+	"QUrl":                            "core",
+	"QUrlQuery":                       "core",
+	"QUrlTwoFlags":                    "core",
+	"QUuid":                           "core",
+	"QVarLengthArray":                 "core",
+	"QVariant":                        "core",
+	"QVariantAnimation":               "core",
+	"QVector":                         "core",
+	"QVectorIterator":                 "core",
+	"QVersionNumber":                  "core",
+	"QWaitCondition":                  "core",
+	"QWeakPointer":                    "core",
+	"QWinEventNotifier":               "core",
+	"QWriteLocker":                    "core",
+	"QXmlStreamAttribute":             "core",
+	"QXmlStreamAttributes":            "core",
+	"QXmlStreamEntityDeclaration":     "core",
+	"QXmlStreamEntityResolver":        "core",
+	"QXmlStreamNamespaceDeclaration":  "core",
+	"QXmlStreamNotationDeclaration":   "core",
+	"QXmlStreamReader":                "core",
+	"QXmlStreamWriter":                "core",
+	"Qt":                              "core",
+	"QtGlobal":                        "core",
+	// gui
+	"QAbstractOpenGLFunctions":         "gui",
+	"QAbstractTextDocumentLayout":      "gui",
+	"QAbstractUndoItem":                "gui",
+	"QAccessible":                      "gui",
+	"QAccessibleActionInterface":       "gui",
+	"QAccessibleEditableTextInterface": "gui",
+	"QAccessibleEvent":                 "gui",
+	"QAccessibleInterface":             "gui",
+	"QAccessibleObject":                "gui",
+	"QAccessiblePlugin":                "gui",
+	"QAccessibleStateChangeEvent":      "gui",
+	"QAccessibleTableCellInterface":    "gui",
+	"QAccessibleTableInterface":        "gui",
+	"QAccessibleTableModelChangeEvent": "gui",
+	"QAccessibleTextCursorEvent":       "gui",
+	"QAccessibleTextInsertEvent":       "gui",
+	"QAccessibleTextInterface":         "gui",
+	"QAccessibleTextRemoveEvent":       "gui",
+	"QAccessibleTextSelectionEvent":    "gui",
+	"QAccessibleTextUpdateEvent":       "gui",
+	"QAccessibleValueChangeEvent":      "gui",
+	"QAccessibleValueInterface":        "gui",
+	"QActionEvent":                     "gui",
+	"QApplicationStateChangeEvent":     "gui",
+	"QBackingStore":                    "gui",
+	"QBitmap":                          "gui",
+	"QBrush":                           "gui",
+	"QClipboard":                       "gui",
+	"QCloseEvent":                      "gui",
+	"QColor":                           "gui",
+	"QColorDialogOptions":              "gui",
+	"QConicalGradient":                 "gui",
+	"QContextMenuEvent":                "gui",
+	"QCursor":                          "gui",
+	"QDesktopServices":                 "gui",
+	"QDoubleValidator":                 "gui",
+	"QDrag":                            "gui",
+	"QDragEnterEvent":                  "gui",
+	"QDragLeaveEvent":                  "gui",
+	"QDragMoveEvent":                   "gui",
+	"QDropEvent":                       "gui",
+	"QEnterEvent":                      "gui",
+	"QExposeEvent":                     "gui",
+	"QFileDialogOptions":               "gui",
+	"QFileOpenEvent":                   "gui",
+	"QFocusEvent":                      "gui",
+	"QFont":                            "gui",
+	"QFontDatabase":                    "gui",
+	"QFontDialogOptions":               "gui",
+	"QFontInfo":                        "gui",
+	"QFontMetrics":                     "gui",
+	"QFontMetricsF":                    "gui",
+	"QGenericMatrix":                   "gui",
+	"QGenericPlugin":                   "gui",
+	"QGenericPluginFactory":            "gui",
+	"QGlyphRun":                        "gui",
+	"QGradient":                        "gui",
+	"QGuiApplication":                  "gui",
+	"QHelpEvent":                       "gui",
+	"QHideEvent":                       "gui",
+	"QHoverEvent":                      "gui",
+	"QIcon":                            "gui",
+	"QIconDragEvent":                   "gui",
+	"QIconEngine":                      "gui",
+	"QIconEnginePlugin":                "gui",
+	"QImage":                           "gui",
+	"QImageIOHandler":                  "gui",
+	"QImageIOPlugin":                   "gui",
+	"QImageReader":                     "gui",
+	"QImageTextKeyLang":                "gui",
+	"QImageWriter":                     "gui",
+	"QInputEvent":                      "gui",
+	"QInputMethod":                     "gui",
+	"QInputMethodEvent":                "gui",
+	"QInputMethodQueryEvent":           "gui",
+	"QIntValidator":                    "gui",
+	"QKeyEvent":                        "gui",
+	"QKeySequence":                     "gui",
+	"QLinearGradient":                  "gui",
+	"QMatrix":                          "gui",
+	"QMatrix4x4":                       "gui",
+	"QMessageDialogOptions":            "gui",
+	"QMouseEvent":                      "gui",
+	"QMoveEvent":                       "gui",
+	"QMovie":                           "gui",
+	"QNativeGestureEvent":              "gui",
+	"QOffscreenSurface":                "gui",
+	"QOpenGLBuffer":                    "gui",
+	"QOpenGLContext":                   "gui",
+	"QOpenGLContextGroup":              "gui",
+	"QOpenGLDebugLogger":               "gui",
+	"QOpenGLDebugMessage":              "gui",
+	"QOpenGLExtraFunctions":            "gui",
+	"QOpenGLFramebufferObject":         "gui",
+	"QOpenGLFramebufferObjectFormat":   "gui",
+	"QOpenGLFunctions":                 "gui",
+	"QOpenGLPaintDevice":               "gui",
+	"QOpenGLPixelTransferOptions":      "gui",
+	"QOpenGLShader":                    "gui",
+	"QOpenGLShaderProgram":             "gui",
+	"QOpenGLTexture":                   "gui",
+	"QOpenGLTextureBlitter":            "gui",
+	"QOpenGLTimeMonitor":               "gui",
+	"QOpenGLTimerQuery":                "gui",
+	"QOpenGLVersionFunctionsBackend":   "gui",
+	"QOpenGLVersionFunctionsStorage":   "gui",
+	"QOpenGLVersionProfile":            "gui",
+	"QOpenGLVersionStatus":             "gui",
+	"QOpenGLVertexArrayObject":         "gui",
+	"QOpenGLWindow":                    "gui",
+	"QPageLayout":                      "gui",
+	"QPageSize":                        "gui",
+	"QPagedPaintDevice":                "gui",
+	"QPaintDevice":                     "gui",
+	"QPaintDeviceWindow":               "gui",
+	"QPaintEngine":                     "gui",
+	"QPaintEngineState":                "gui",
+	"QPaintEvent":                      "gui",
+	"QPainter":                         "gui",
+	"QPainterPath":                     "gui",
+	"QPainterPathStroker":              "gui",
+	"QPalette":                         "gui",
+	"QPdfWriter":                       "gui",
+	"QPen":                             "gui",
+	"QPicture":                         "gui",
+	"QPictureFormatPlugin":             "gui",
+	"QPictureIO":                       "gui",
+	"QPixelFormat":                     "gui",
+	"QPixmap":                          "gui",
+	"QPixmapCache":                     "gui",
+	"QPlatformDragQtResponse":          "gui",
+	"QPlatformDropQtResponse":          "gui",
+	"QPlatformIntegrationPlugin":       "gui",
+	"QPlatformMenu":                    "gui",
+	"QPlatformMenuBar":                 "gui",
+	"QPlatformMenuItem":                "gui",
+	"QPlatformOffscreenSurface":        "gui",
+	"QPlatformSessionManager":          "gui",
+	"QPlatformSurfaceEvent":            "gui",
+	"QPlatformTextureList":             "gui",
+	"QPointingDeviceUniqueId":          "gui",
+	"QPolygon":                         "gui",
+	"QPolygonF":                        "gui",
+	"QQuaternion":                      "gui",
+	"QRadialGradient":                  "gui",
+	"QRasterPaintEngine":               "gui",
+	"QRasterWindow":                    "gui",
+	"QRawFont":                         "gui",
+	"QRegExpValidator":                 "gui",
+	"QRegion":                          "gui",
+	"QRegularExpressionValidator":      "gui",
+	"QResizeEvent":                     "gui",
+	"QRgba64":                          "gui",
+	"QScreen":                          "gui",
+	"QScreenOrientationChangeEvent":    "gui",
+	"QScrollEvent":                     "gui",
+	"QScrollPrepareEvent":              "gui",
+	"QSessionManager":                  "gui",
+	"QShortcutEvent":                   "gui",
+	"QShowEvent":                       "gui",
+	"QStandardItem":                    "gui",
+	"QStandardItemModel":               "gui",
+	"QStaticText":                      "gui",
+	"QStatusTipEvent":                  "gui",
+	"QStyleHints":                      "gui",
+	"QSupportedWritingSystems":         "gui",
+	"QSurface":                         "gui",
+	"QSurfaceFormat":                   "gui",
+	"QSyntaxHighlighter":               "gui",
+	"QTabletEvent":                     "gui",
+	"QTextBlock":                       "gui",
+	"QTextBlockFormat":                 "gui",
+	"QTextBlockGroup":                  "gui",
+	"QTextBlockUserData":               "gui",
+	"QTextCharFormat":                  "gui",
+	"QTextCursor":                      "gui",
+	"QTextDocument":                    "gui",
+	"QTextDocumentFragment":            "gui",
+	"QTextDocumentWriter":              "gui",
+	"QTextFormat":                      "gui",
+	"QTextFragment":                    "gui",
+	"QTextFrame":                       "gui",
+	"QTextFrameFormat":                 "gui",
+	"QTextFrameLayoutData":             "gui",
+	"QTextImageFormat":                 "gui",
+	"QTextInlineObject":                "gui",
+	"QTextItem":                        "gui",
+	"QTextLayout":                      "gui",
+	"QTextLength":                      "gui",
+	"QTextLine":                        "gui",
+	"QTextList":                        "gui",
+	"QTextListFormat":                  "gui",
+	"QTextObject":                      "gui",
+	"QTextObjectInterface":             "gui",
+	"QTextOption":                      "gui",
+	"QTextTable":                       "gui",
+	"QTextTableCell":                   "gui",
+	"QTextTableCellFormat":             "gui",
+	"QTextTableFormat":                 "gui",
+	"QTouchDevice":                     "gui",
+	"QTouchEvent":                      "gui",
+	"QTransform":                       "gui",
+	"QValidator":                       "gui",
+	"QVector2D":                        "gui",
+	"QVector3D":                        "gui",
+	"QVector4D":                        "gui",
+	"QVulkanDeviceFunctions":           "gui",
+	"QVulkanExtension":                 "gui",
+	"QVulkanFunctions":                 "gui",
+	"QVulkanInfoVector":                "gui",
+	"QVulkanInstance":                  "gui",
+	"QVulkanLayer":                     "gui",
+	"QVulkanWindow":                    "gui",
+	"QVulkanWindowRenderer":            "gui",
+	"QWhatsThisClickedEvent":           "gui",
+	"QWheelEvent":                      "gui",
+	"QWindow":                          "gui",
+	"QWindowStateChangeEvent":          "gui",
+	// widgets
+	"QAbstractButton":                "widgets",
+	"QAbstractGraphicsShapeItem":     "widgets",
+	"QAbstractItemDelegate":          "widgets",
+	"QAbstractItemView":              "widgets",
+	"QAbstractScrollArea":            "widgets",
+	"QAbstractSlider":                "widgets",
+	"QAbstractSpinBox":               "widgets",
+	"QAccessibleWidget":              "widgets",
+	"QAction":                        "widgets",
+	"QActionGroup":                   "widgets",
+	"QApplication":                   "widgets",
+	"QBoxLayout":                     "widgets",
+	"QButtonGroup":                   "widgets",
+	"QCalendarWidget":                "widgets",
+	"QCheckBox":                      "widgets",
+	"QColorDialog":                   "widgets",
+	"QColormap":                      "widgets",
+	"QColumnView":                    "widgets",
+	"QComboBox":                      "widgets",
+	"QCommandLinkButton":             "widgets",
+	"QCommonStyle":                   "widgets",
+	"QCompleter":                     "widgets",
+	"QDataWidgetMapper":              "widgets",
+	"QDateEdit":                      "widgets",
+	"QDateTimeEdit":                  "widgets",
+	"QDesktopWidget":                 "widgets",
+	"QDial":                          "widgets",
+	"QDialog":                        "widgets",
+	"QDialogButtonBox":               "widgets",
+	"QDirModel":                      "widgets",
+	"QDockWidget":                    "widgets",
+	"QDoubleSpinBox":                 "widgets",
+	"QErrorMessage":                  "widgets",
+	"QFileDialog":                    "widgets",
+	"QFileIconProvider":              "widgets",
+	"QFileSystemModel":               "widgets",
+	"QFocusFrame":                    "widgets",
+	"QFontComboBox":                  "widgets",
+	"QFontDialog":                    "widgets",
+	"QFormLayout":                    "widgets",
+	"QFrame":                         "widgets",
+	"QGesture":                       "widgets",
+	"QGestureEvent":                  "widgets",
+	"QGestureRecognizer":             "widgets",
+	"QGraphicsAnchor":                "widgets",
+	"QGraphicsAnchorLayout":          "widgets",
+	"QGraphicsBlurEffect":            "widgets",
+	"QGraphicsColorizeEffect":        "widgets",
+	"QGraphicsDropShadowEffect":      "widgets",
+	"QGraphicsEffect":                "widgets",
+	"QGraphicsEllipseItem":           "widgets",
+	"QGraphicsGridLayout":            "widgets",
+	"QGraphicsItem":                  "widgets",
+	"QGraphicsItemAnimation":         "widgets",
+	"QGraphicsItemGroup":             "widgets",
+	"QGraphicsLayout":                "widgets",
+	"QGraphicsLayoutItem":            "widgets",
+	"QGraphicsLineItem":              "widgets",
+	"QGraphicsLinearLayout":          "widgets",
+	"QGraphicsObject":                "widgets",
+	"QGraphicsOpacityEffect":         "widgets",
+	"QGraphicsPathItem":              "widgets",
+	"QGraphicsPixmapItem":            "widgets",
+	"QGraphicsPolygonItem":           "widgets",
+	"QGraphicsProxyWidget":           "widgets",
+	"QGraphicsRectItem":              "widgets",
+	"QGraphicsRotation":              "widgets",
+	"QGraphicsScale":                 "widgets",
+	"QGraphicsScene":                 "widgets",
+	"QGraphicsSceneContextMenuEvent": "widgets",
+	"QGraphicsSceneDragDropEvent":    "widgets",
+	"QGraphicsSceneEvent":            "widgets",
+	"QGraphicsSceneHelpEvent":        "widgets",
+	"QGraphicsSceneHoverEvent":       "widgets",
+	"QGraphicsSceneMouseEvent":       "widgets",
+	"QGraphicsSceneMoveEvent":        "widgets",
+	"QGraphicsSceneResizeEvent":      "widgets",
+	"QGraphicsSceneWheelEvent":       "widgets",
+	"QGraphicsSimpleTextItem":        "widgets",
+	"QGraphicsTextItem":              "widgets",
+	"QGraphicsTransform":             "widgets",
+	"QGraphicsView":                  "widgets",
+	"QGraphicsWidget":                "widgets",
+	"QGridLayout":                    "widgets",
+	"QGroupBox":                      "widgets",
+	"QHBoxLayout":                    "widgets",
+	"QHeaderView":                    "widgets",
+	"QInputDialog":                   "widgets",
+	"QItemDelegate":                  "widgets",
+	"QItemEditorCreator":             "widgets",
+	"QItemEditorCreatorBase":         "widgets",
+	"QItemEditorFactory":             "widgets",
+	"QKeyEventTransition":            "widgets",
+	"QKeySequenceEdit":               "widgets",
+	"QLCDNumber":                     "widgets",
+	"QLabel":                         "widgets",
+	"QLayout":                        "widgets",
+	"QLayoutItem":                    "widgets",
+	"QLineEdit":                      "widgets",
+	"QListView":                      "widgets",
+	"QListWidget":                    "widgets",
+	"QListWidgetItem":                "widgets",
+	"QMainWindow":                    "widgets",
+	"QMdiArea":                       "widgets",
+	"QMdiSubWindow":                  "widgets",
+	"QMenu":                          "widgets",
+	"QMenuBar":                       "widgets",
+	"QMessageBox":                    "widgets",
+	"QMouseEventTransition":          "widgets",
+	"QOpenGLWidget":                  "widgets",
+	"QPanGesture":                    "widgets",
+	"QPinchGesture":                  "widgets",
+	"QPlainTextDocumentLayout":       "widgets",
+	"QPlainTextEdit":                 "widgets",
+	"QProgressBar":                   "widgets",
+	"QProgressDialog":                "widgets",
+	"QProxyStyle":                    "widgets",
+	"QPushButton":                    "widgets",
+	"QRadioButton":                   "widgets",
+	"QRubberBand":                    "widgets",
+	"QScrollArea":                    "widgets",
+	"QScrollBar":                     "widgets",
+	"QScroller":                      "widgets",
+	"QScrollerProperties":            "widgets",
+	"QShortcut":                      "widgets",
+	"QSizeGrip":                      "widgets",
+	"QSizePolicy":                    "widgets",
+	"QSlider":                        "widgets",
+	"QSpacerItem":                    "widgets",
+	"QSpinBox":                       "widgets",
+	"QSplashScreen":                  "widgets",
+	"QSplitter":                      "widgets",
+	"QSplitterHandle":                "widgets",
+	"QStackedLayout":                 "widgets",
+	"QStackedWidget":                 "widgets",
+	"QStandardItemEditorCreator":     "widgets",
+	"QStatusBar":                     "widgets",
+	"QStyle":                         "widgets",
+	"QStyleFactory":                  "widgets",
+	"QStyleHintReturn":               "widgets",
+	"QStyleHintReturnMask":           "widgets",
+	"QStyleHintReturnVariant":        "widgets",
+	"QStyleOption":                   "widgets",
+	"QStyleOptionButton":             "widgets",
+	"QStyleOptionComboBox":           "widgets",
+	"QStyleOptionComplex":            "widgets",
+	"QStyleOptionDockWidget":         "widgets",
+	"QStyleOptionFocusRect":          "widgets",
+	"QStyleOptionFrame":              "widgets",
+	"QStyleOptionGraphicsItem":       "widgets",
+	"QStyleOptionGroupBox":           "widgets",
+	"QStyleOptionHeader":             "widgets",
+	"QStyleOptionMenuItem":           "widgets",
+	"QStyleOptionProgressBar":        "widgets",
+	"QStyleOptionRubberBand":         "widgets",
+	"QStyleOptionSizeGrip":           "widgets",
+	"QStyleOptionSlider":             "widgets",
+	"QStyleOptionSpinBox":            "widgets",
+	"QStyleOptionTab":                "widgets",
+	"QStyleOptionTabBarBase":         "widgets",
+	"QStyleOptionTabWidgetFrame":     "widgets",
+	"QStyleOptionTitleBar":           "widgets",
+	"QStyleOptionToolBar":            "widgets",
+	"QStyleOptionToolBox":            "widgets",
+	"QStyleOptionToolButton":         "widgets",
+	"QStyleOptionViewItem":           "widgets",
+	"QStylePainter":                  "widgets",
+	"QStylePlugin":                   "widgets",
+	"QStyledItemDelegate":            "widgets",
+	"QSwipeGesture":                  "widgets",
+	"QSystemTrayIcon":                "widgets",
+	"QTabBar":                        "widgets",
+	"QTabWidget":                     "widgets",
+	"QTableView":                     "widgets",
+	"QTableWidget":                   "widgets",
+	"QTableWidgetItem":               "widgets",
+	"QTableWidgetSelectionRange":     "widgets",
+	"QTapAndHoldGesture":             "widgets",
+	"QTapGesture":                    "widgets",
+	"QTextBrowser":                   "widgets",
+	"QTextEdit":                      "widgets",
+	"QTileRules":                     "widgets",
+	"QTimeEdit":                      "widgets",
+	"QToolBar":                       "widgets",
+	"QToolBox":                       "widgets",
+	"QToolButton":                    "widgets",
+	"QToolTip":                       "widgets",
+	"QTreeView":                      "widgets",
+	"QTreeWidget":                    "widgets",
+	"QTreeWidgetItem":                "widgets",
+	"QTreeWidgetItemIterator":        "widgets",
+	"QUndoCommand":                   "widgets",
+	"QUndoGroup":                     "widgets",
+	"QUndoStack":                     "widgets",
+	"QUndoView":                      "widgets",
+	"QVBoxLayout":                    "widgets",
+	"QWhatsThis":                     "widgets",
+	"QWidget":                        "widgets",
+	"QWidgetAction":                  "widgets",
+	"QWidgetItem":                    "widgets",
+	"QWizard":                        "widgets",
+	"QWizardPage":                    "widgets",
+}
+
+// ClassBases maps the Qt classes of the therecipe binding to their first base
+// class.
+var ClassBases = map[string]string{
+	"QAbstractAnimation":               "QObject",
+	"QAbstractButton":                  "QWidget",
+	"QAbstractEventDispatcher":         "QObject",
+	"QAbstractGraphicsShapeItem":       "QGraphicsItem",
+	"QAbstractItemDelegate":            "QObject",
+	"QAbstractItemModel":               "QObject",
+	"QAbstractItemView":                "QAbstractScrollArea",
+	"QAbstractListModel":               "QAbstractItemModel",
+	"QAbstractProxyModel":              "QAbstractItemModel",
+	"QAbstractScrollArea":              "QFrame",
+	"QAbstractSlider":                  "QWidget",
+	"QAbstractSpinBox":                 "QWidget",
+	"QAbstractState":                   "QObject",
+	"QAbstractTableModel":              "QAbstractItemModel",
+	"QAbstractTextDocumentLayout":      "QObject",
+	"QAbstractTransition":              "QObject",
+	"QAccessibleObject":                "QAccessibleInterface",
+	"QAccessiblePlugin":                "QObject",
+	"QAccessibleStateChangeEvent":      "QAccessibleEvent",
+	"QAccessibleTableModelChangeEvent": "QAccessibleEvent",
+	"QAccessibleTextCursorEvent":       "QAccessibleEvent",
+	"QAccessibleTextInsertEvent":       "QAccessibleTextCursorEvent",
+	"QAccessibleTextRemoveEvent":       "QAccessibleTextCursorEvent",
+	"QAccessibleTextSelectionEvent":    "QAccessibleTextCursorEvent",
+	"QAccessibleTextUpdateEvent":       "QAccessibleTextCursorEvent",
+	"QAccessibleValueChangeEvent":      "QAccessibleEvent",
+	"QAccessibleWidget":                "QAccessibleObject",
+	"QAction":                          "QObject",
+	"QActionEvent":                     "QEvent",
+	"QActionGroup":                     "QObject",
+	"QAnimationGroup":                  "QAbstractAnimation",
+	"QApplication":                     "QGuiApplication",
+	"QApplicationStateChangeEvent":     "QEvent",
+	"QArgument":                        "QGenericArgument",
+	"QAtomicInt":                       "QAtomicInteger",
+	"QAtomicInteger":                   "QBasicAtomicInteger",
+	"QAtomicPointer":                   "QBasicAtomicPointer",
+	"QBitmap":                          "QPixmap",
+	"QBoxLayout":                       "QLayout",
+	"QBuffer":                          "QIODevice",
+	"QButtonGroup":                     "QObject",
+	"QCalendarWidget":                  "QWidget",
+	"QCheckBox":                        "QAbstractButton",
+	"QChildEvent":                      "QEvent",
+	"QClipboard":                       "QObject",
+	"QCloseEvent":                      "QEvent",
+	"QColorDialog":                     "QDialog",
+	"QColumnView":                      "QAbstractItemView",
+	"QComboBox":                        "QWidget",
+	"QCommandLinkButton":               "QPushButton",
+	"QCommonStyle":                     "QStyle",
+	"QCompleter":                       "QObject",
+	"QConcatenateTablesProxyModel":     "QAbstractItemModel",
+	"QConicalGradient":                 "QGradient",
+	"QContextMenuEvent":                "QInputEvent",
+	"QCoreApplication":                 "QObject",
+	"QDataWidgetMapper":                "QObject",
+	"QDateEdit":                        "QDateTimeEdit",
+	"QDateTimeEdit":                    "QAbstractSpinBox",
+	"QDeferredDeleteEvent":             "QEvent",
+	"QDesktopWidget":                   "QWidget",
+	"QDial":                            "QAbstractSlider",
+	"QDialog":                          "QWidget",
+	"QDialogButtonBox":                 "QWidget",
+	"QDirModel":                        "QAbstractItemModel",
+	"QDockWidget":                      "QWidget",
+	"QDoubleSpinBox":                   "QAbstractSpinBox",
+	"QDoubleValidator":                 "QValidator",
+	"QDrag":                            "QObject",
+	"QDragEnterEvent":                  "QDragMoveEvent",
+	"QDragLeaveEvent":                  "QEvent",
+	"QDragMoveEvent":                   "QDropEvent",
+	"QDropEvent":                       "QEvent",
+	"QDynamicPropertyChangeEvent":      "QEvent",
+	"QEnterEvent":                      "QEvent",
+	"QErrorMessage":                    "QDialog",
+	"QEventLoop":                       "QObject",
+	"QEventTransition":                 "QAbstractTransition",
+	"QExposeEvent":                     "QEvent",
+	"QFile":                            "QFileDevice",
+	"QFileDevice":                      "QIODevice",
+	"QFileDialog":                      "QDialog",
+	"QFileOpenEvent":                   "QEvent",
+	"QFileSelector":                    "QObject",
+	"QFileSystemModel":                 "QAbstractItemModel",
+	"QFileSystemWatcher":               "QObject",
+	"QFinalState":                      "QAbstractState",
+	"QFocusEvent":                      "QEvent",
+	"QFocusFrame":                      "QWidget",
+	"QFontComboBox":                    "QComboBox",
+	"QFontDialog":                      "QDialog",
+	"QFormLayout":                      "QLayout",
+	"QFrame":                           "QWidget",
+	"QFutureInterface":                 "QFutureInterfaceBase",
+	"QFutureWatcher":                   "QObject",
+	"QFutureWatcherBase":               "QObject",
+	"QGenericPlugin":                   "QObject",
+	"QGenericReturnArgument":           "QGenericArgument",
+	"QGesture":                         "QObject",
+	"QGestureEvent":                    "QEvent",
+	"QGraphicsAnchor":                  "QObject",
+	"QGraphicsAnchorLayout":            "QGraphicsLayout",
+	"QGraphicsBlurEffect":              "QGraphicsEffect",
+	"QGraphicsColorizeEffect":          "QGraphicsEffect",
+	"QGraphicsDropShadowEffect":        "QGraphicsEffect",
+	"QGraphicsEffect":                  "QObject",
+	"QGraphicsEllipseItem":             "QAbstractGraphicsShapeItem",
+	"QGraphicsGridLayout":              "QGraphicsLayout",
+	"QGraphicsItemAnimation":           "QObject",
+	"QGraphicsItemGroup":               "QGraphicsItem",
+	"QGraphicsLayout":                  "QGraphicsLayoutItem",
+	"QGraphicsLineItem":                "QGraphicsItem",
+	"QGraphicsLinearLayout":            "QGraphicsLayout",
+	"QGraphicsObject":                  "QObject",
+	"QGraphicsOpacityEffect":           "QGraphicsEffect",
+	"QGraphicsPathItem":                "QAbstractGraphicsShapeItem",
+	"QGraphicsPixmapItem":              "QGraphicsItem",
+	"QGraphicsPolygonItem":             "QAbstractGraphicsShapeItem",
+	"QGraphicsProxyWidget":             "QGraphicsWidget",
+	"QGraphicsRectItem":                "QAbstractGraphicsShapeItem",
+	"QGraphicsRotation":                "QGraphicsTransform",
+	"QGraphicsScale":                   "QGraphicsTransform",
+	"QGraphicsScene":                   "QObject",
+	"QGraphicsSceneContextMenuEvent":   "QGraphicsSceneEvent",
+	"QGraphicsSceneDragDropEvent":      "QGraphicsSceneEvent",
+	"QGraphicsSceneEvent":              "QEvent",
+	"QGraphicsSceneHelpEvent":          "QGraphicsSceneEvent",
+	"QGraphicsSceneHoverEvent":         "QGraphicsSceneEvent",
+	"QGraphicsSceneMouseEvent":         "QGraphicsSceneEvent",
+	"QGraphicsSceneMoveEvent":          "QGraphicsSceneEvent",
+	"QGraphicsSceneResizeEvent":        "QGraphicsSceneEvent",
+	"QGraphicsSceneWheelEvent":         "QGraphicsSceneEvent",
+	"QGraphicsSimpleTextItem":          "QAbstractGraphicsShapeItem",
+	"QGraphicsTextItem":                "QGraphicsObject",
+	"QGraphicsTransform":               "QObject",
+	"QGraphicsView":                    "QAbstractScrollArea",
+	"QGraphicsWidget":                  "QGraphicsObject",
+	"QGridLayout":                      "QLayout",
+	"QGroupBox":                        "QWidget",
+	"QGuiApplication":                  "QCoreApplication",
+	"QHBoxLayout":                      "QBoxLayout",
+	"QHeaderView":                      "QAbstractItemView",
+	"QHelpEvent":                       "QEvent",
+	"QHideEvent":                       "QEvent",
+	"QHistoryState":                    "QAbstractState",
+	"QHoverEvent":                      "QInputEvent",
+	"QIODevice":                        "QObject",
+	"QIconDragEvent":                   "QEvent",
+	"QIconEnginePlugin":                "QObject",
+	"QIdentityProxyModel":              "QAbstractProxyModel",
+	"QImage":                           "QPaintDevice",
+	"QImageIOPlugin":                   "QObject",
+	"QInputDialog":                     "QDialog",
+	"QInputEvent":                      "QEvent",
+	"QInputMethod":                     "QObject",
+	"QInputMethodEvent":                "QEvent",
+	"QInputMethodQueryEvent":           "QEvent",
+	"QIntValidator":                    "QValidator",
+	"QItemDelegate":                    "QAbstractItemDelegate",
+	"QItemEditorCreator":               "QItemEditorCreatorBase",
+	"QItemSelectionModel":              "QObject",
+	"QKeyEvent":                        "QInputEvent",
+	"QKeyEventTransition":              "QEventTransition",
+	"QKeySequenceEdit":                 "QWidget",
+	"QLCDNumber":                       "QFrame",
+	"QLabel":                           "QFrame",
+	"QLayout":                          "QObject",
+	"QLibrary":                         "QObject",
+	"QLineEdit":                        "QWidget",
+	"QLinearGradient":                  "QGradient",
+	"QListView":                        "QAbstractItemView",
+	"QListWidget":                      "QListView",
+	"QMainWindow":                      "QWidget",
+	"QMdiArea":                         "QAbstractScrollArea",
+	"QMdiSubWindow":                    "QWidget",
+	"QMenu":                            "QWidget",
+	"QMenuBar":                         "QWidget",
+	"QMessageBox":                      "QDialog",
+	"QMimeData":                        "QObject",
+	"QMouseEvent":                      "QInputEvent",
+	"QMouseEventTransition":            "QEventTransition",
+	"QMoveEvent":                       "QEvent",
+	"QMovie":                           "QObject",
+	"QMultiHash":                       "QHash",
+	"QMultiMap":                        "QMap",
+	"QMutex":                           "QBasicMutex",
+	"QNativeGestureEvent":              "QInputEvent",
+	"QObjectCleanupHandler":            "QObject",
+	"QOffscreenSurface":                "QObject",
+	"QOpenGLContext":                   "QObject",
+	"QOpenGLContextGroup":              "QObject",
+	"QOpenGLDebugLogger":               "QObject",
+	"QOpenGLExtraFunctions":            "QOpenGLFunctions",
+	"QOpenGLPaintDevice":               "QPaintDevice",
+	"QOpenGLShader":                    "QObject",
+	"QOpenGLShaderProgram":             "QObject",
+	"QOpenGLTimeMonitor":               "QObject",
+	"QOpenGLTimerQuery":                "QObject",
+	"QOpenGLVertexArrayObject":         "QObject",
+	"QOpenGLWidget":                    "QWidget",
+	"QOpenGLWindow":                    "QPaintDeviceWindow",
+	"QPagedPaintDevice":                "QPaintDevice",
+	"QPaintDeviceWindow":               "QWindow",
+	"QPaintEvent":                      "QEvent",
+	"QPanGesture":                      "QGesture",
+	"QParallelAnimationGroup":          "QAnimationGroup",
+	"QPauseAnimation":                  "QAbstractAnimation",
+	"QPdfWriter":                       "QObject",
+	"QPicture":                         "QPaintDevice",
+	"QPictureFormatPlugin":             "QObject",
+	"QPinchGesture":                    "QGesture",
+	"QPixmap":                          "QPaintDevice",
+	"QPlainTextDocumentLayout":         "QAbstractTextDocumentLayout",
+	"QPlainTextEdit":                   "QAbstractScrollArea",
+	"QPlatformDragQtResponse":          "QPlatformDropQtResponse",
+	"QPlatformIntegrationPlugin":       "QObject",
+	"QPlatformMenu":                    "QObject",
+	"QPlatformMenuBar":                 "QObject",
+	"QPlatformMenuItem":                "QObject",
+	"QPlatformSurfaceEvent":            "QEvent",
+	"QPlatformTextureList":             "QObject",
+	"QPluginLoader":                    "QObject",
+	"QPolygon":                         "QVector",
+	"QPolygonF":                        "QVector",
+	"QProcess":                         "QIODevice",
+	"QProgressBar":                     "QWidget",
+	"QProgressDialog":                  "QDialog",
+	"QPropertyAnimation":               "QVariantAnimation",
+	"QProxyStyle":                      "QCommonStyle",
+	"QPushButton":                      "QAbstractButton",
+	"QRadialGradient":                  "QGradient",
+	"QRadioButton":                     "QAbstractButton",
+	"QRandomGenerator64":               "QRandomGenerator",
+	"QRasterPaintEngine":               "QPaintEngine",
+	"QRasterWindow":                    "QPaintDeviceWindow",
+	"QRegExpValidator":                 "QValidator",
+	"QRegularExpressionValidator":      "QValidator",
+	"QResizeEvent":                     "QEvent",
+	"QReturnArgument":                  "QGenericReturnArgument",
+	"QRubberBand":                      "QWidget",
+	"QSaveFile":                        "QFileDevice",
+	"QScopedArrayPointer":              "QScopedPointer",
+	"QScreen":                          "QObject",
+	"QScreenOrientationChangeEvent":    "QEvent",
+	"QScrollArea":                      "QAbstractScrollArea",
+	"QScrollBar":                       "QAbstractSlider",
+	"QScrollEvent":                     "QEvent",
+	"QScrollPrepareEvent":              "QEvent",
+	"QScroller":                        "QObject",
+	"QSequentialAnimationGroup":        "QAnimationGroup",
+	"QSessionManager":                  "QObject",
+	"QSettings":                        "QObject",
+	"QSharedMemory":                    "QObject",
+	"QShortcut":                        "QObject",
+	"QShortcutEvent":                   "QEvent",
+	"QShowEvent":                       "QEvent",
+	"QSignalMapper":                    "QObject",
+	"QSignalTransition":                "QAbstractTransition",
+	"QSizeGrip":                        "QWidget",
+	"QSlider":                          "QAbstractSlider",
+	"QSocketNotifier":                  "QObject",
+	"QSortFilterProxyModel":            "QAbstractProxyModel",
+	"QSpacerItem":                      "QLayoutItem",
+	"QSpinBox":                         "QAbstractSpinBox",
+	"QSplashScreen":                    "QWidget",
+	"QSplitter":                        "QFrame",
+	"QSplitterHandle":                  "QWidget",
+	"QStack":                           "QVector",
+	"QStackedLayout":                   "QLayout",
+	"QStackedWidget":                   "QFrame",
+	"QStandardItemEditorCreator":       "QItemEditorCreatorBase",
+	"QStandardItemModel":               "QAbstractItemModel",
+	"QState":                           "QAbstractState",
+	"QStateMachine":                    "QState",
+	"QStatusBar":                       "QWidget",
+	"QStatusTipEvent":                  "QEvent",
+	"QStringListModel":                 "QAbstractListModel",
+	"QStyle":                           "QObject",
+	"QStyleHintReturnMask":             "QStyleHintReturn",
+	"QStyleHintReturnVariant":          "QStyleHintReturn",
+	"QStyleHints":                      "QObject",
+	"QStyleOptionButton":               "QStyleOption",
+	"QStyleOptionComboBox":             "QStyleOptionComplex",
+	"QStyleOptionComplex":              "QStyleOption",
+	"QStyleOptionDockWidget":           "QStyleOption",
+	"QStyleOptionFocusRect":            "QStyleOption",
+	"QStyleOptionFrame":                "QStyleOption",
+	"QStyleOptionGraphicsItem":         "QStyleOption",
+	"QStyleOptionGroupBox":             "QStyleOptionComplex",
+	"QStyleOptionHeader":               "QStyleOption",
+	"QStyleOptionMenuItem":             "QStyleOption",
+	"QStyleOptionProgressBar":          "QStyleOption",
+	"QStyleOptionRubberBand":           "QStyleOption",
+	"QStyleOptionSizeGrip":             "QStyleOptionComplex",
+	"QStyleOptionSlider":               "QStyleOptionComplex",
+	"QStyleOptionSpinBox":              "QStyleOptionComplex",
+	"QStyleOptionTab":                  "QStyleOption",
+	"QStyleOptionTabBarBase":           "QStyleOption",
+	"QStyleOptionTabWidgetFrame":       "QStyleOption",
+	"QStyleOptionTitleBar":             "QStyleOptionComplex",
+	"QStyleOptionToolBar":              "QStyleOption",
+	"QStyleOptionToolBox":              "QStyleOption",
+	"QStyleOptionToolButton":           "QStyleOptionComplex",
+	"QStyleOptionViewItem":             "QStyleOption",
+	"QStylePainter":                    "QPainter",
+	"QStylePlugin":                     "QObject",
+	"QStyledItemDelegate":              "QAbstractItemDelegate",
+	"QSwipeGesture":                    "QGesture",
+	"QSyntaxHighlighter":               "QObject",
+	"QSystemTrayIcon":                  "QObject",
+	"QTabBar":                          "QWidget",
+	"QTabWidget":                       "QWidget",
+	"QTableView":                       "QAbstractItemView",
+	"QTableWidget":                     "QTableView",
+	"QTabletEvent":                     "QInputEvent",
+	"QTapAndHoldGesture":               "QGesture",
+	"QTapGesture":                      "QGesture",
+	"QTemporaryFile":                   "QFile",
+	"QTextBlockFormat":                 "QTextFormat",
+	"QTextBlockGroup":                  "QTextObject",
+	"QTextBrowser":                     "QTextEdit",
+	"QTextCharFormat":                  "QTextFormat",
+	"QTextDocument":                    "QObject",
+	"QTextEdit":                        "QAbstractScrollArea",
+	"QTextFrame":                       "QTextObject",
+	"QTextFrameFormat":                 "QTextFormat",
+	"QTextImageFormat":                 "QTextCharFormat",
+	"QTextList":                        "QTextBlockGroup",
+	"QTextListFormat":                  "QTextFormat",
+	"QTextObject":                      "QObject",
+	"QTextTable":                       "QTextFrame",
+	"QTextTableCellFormat":             "QTextCharFormat",
+	"QTextTableFormat":                 "QTextFrameFormat",
+	"QThread":                          "QObject",
+	"QThreadPool":                      "QObject",
+	"QTimeEdit":                        "QDateTimeEdit",
+	"QTimeLine":                        "QObject",
+	"QTimer":                           "QObject",
+	"QTimerEvent":                      "QEvent",
+	"QToolBar":                         "QWidget",
+	"QToolBox":                         "QFrame",
+	"QToolButton":                      "QAbstractButton",
+	"QTouchEvent":                      "QInputEvent",
+	"QTranslator":                      "QObject",
+	"QTransposeProxyModel":             "QAbstractProxyModel",
+	"QTreeView":                        "QAbstractItemView",
+	"QTreeWidget":                      "QTreeView",
+	"QTypedArrayData":                  "QArrayData",
+	"QUndoGroup":                       "QObject",
+	"QUndoStack":                       "QObject",
+	"QUndoView":                        "QListView",
+	"QUnhandledException":              "QException",
+	"QVBoxLayout":                      "QBoxLayout",
+	"QValidator":                       "QObject",
+	"QVariantAnimation":                "QAbstractAnimation",
+	"QVulkanInfoVector":                "QVector",
+	"QVulkanWindow":                    "QWindow",
+	"QWhatsThisClickedEvent":           "QEvent",
+	"QWheelEvent":                      "QInputEvent",
+	"QWidget":                          "QObject",
+	"QWidgetAction":                    "QAction",
+	"QWidgetItem":                      "QLayoutItem",
+	"QWinEventNotifier":                "QObject",
+	"QWindow":                          "QObject",
+	"QWindowStateChangeEvent":          "QEvent",
+	"QWizard":                          "QDialog",
+	"QWizardPage":                      "QWidget",
+	"QXmlStreamAttributes":             "QVector",
+}
@@ -4,9 +4,10 @@ import (
 	"bytes"
 	"fmt"
 	"github.com/z-ray/log"
-	"io/ioutil"
+	"io"
 	"os"
 	"path/filepath"
+	"sort"
 	"strconv"
 	"strings"
 	"unicode"
@@ -16,9 +17,29 @@ import (
 type compiler struct {
 	*parser
 
+	// Diagnostics receives warnings and errors in file:line:col: message form.
+	Diagnostics io.Writer
+
+	errorCount int // errors reported to Diagnostics
+
+	// ResourceImports maps .qrc files to the Go packages generated for them
+	// by "goqtuic qrc". The generated code imports the packages of the .qrc
+	// files referenced by the ui file, which registers their resources.
+	ResourceImports map[string]string
+
+	// ConnectSlotsByName makes SetupUI take a handler whose methods named
+	// On<Widget><Signal>, e.g. OnOkButtonClicked, are connected to the
+	// signals of the widgets, like Qt's QMetaObject::connectSlotsByName. The
+	// root widget is named like the SetupUI parameter, e.g. OnFormDestroyed.
+	ConnectSlotsByName bool
+
 	RootWidgetName string
 
-	Imports map[string]bool
+	Imports map[string]string // import path -> package name, "" for default
+
+	packageNames map[string]string // import path -> package name qualifiers use
+
+	resourcePaths map[string]bool
 
 	FontDefined       bool
 	SizePolicyDefined bool
@@ -39,6 +60,7 @@ type compiler struct {
 
 	SetCurrentIndexCodes []string
 
+	DefinedGradients    map[string]bool
 	DefinedButtonGroups map[string]bool
 	DefinedTreeItems    map[string]bool
 }
@@ -117,12 +139,33 @@ func NewCompiler(uiFile string) (error, *compiler) {
 
 	return nil, &compiler{
 		parser:              parser,
-		Imports:             make(map[string]bool),
+		Diagnostics:         os.Stderr,
+		ResourceImports:     make(map[string]string),
+		Imports:             make(map[string]string),
+		packageNames:        make(map[string]string),
+		DefinedGradients:    make(map[string]bool),
 		DefinedButtonGroups: make(map[string]bool),
 		DefinedTreeItems:    make(map[string]bool),
 	}
 }
 
+func (this *compiler) diagnostic(pos Position, msg string) {
+	if pos.String() == "" {
+		fmt.Fprintln(this.Diagnostics, msg)
+	} else {
+		fmt.Fprintf(this.Diagnostics, "%s: %s\n", pos, msg)
+	}
+}
+
+func (this *compiler) errorf(pos Position, format string, args ...interface{}) {
+	this.errorCount++
+	this.diagnostic(pos, fmt.Sprintf(format, args...))
+}
+
+func (this *compiler) warnf(pos Position, format string, args ...interface{}) {
+	this.diagnostic(pos, "warning: "+fmt.Sprintf(format, args...))
+}
+
 func (this *compiler) addVariableCode(line string) {
 	this.VariableCodes = append(this.VariableCodes, line)
 }
@@ -158,36 +201,20 @@ func (this *compiler) transVarName(s string) string {
 	return strings.Replace(ToCamelCase(s), "_", "", -1)
 }
 
+// addImport adds an import to the generated code. Bare names refer to the
+// therecipe qt modules, e.g. "core" or "widgets".
 func (this *compiler) addImport(_import string) {
+	if !strings.Contains(_import, "/") {
+		_import = "github.com/therecipe/qt/" + _import
+	}
 	if _, ok := this.Imports[_import]; ok {
 		return
 	}
-	this.Imports[_import] = true
+	this.Imports[_import] = ""
 }
 
-func (this *compiler) enumToString(enum string) string {
-	parts := strings.Split(enum, "::")
-
-	ns := parts[0]
-	switch ns {
-	case "Qt":
-		this.addImport("core")
-		return fmt.Sprintf("core.%s", strings.Replace(enum, ":", "_", -1))
-	case "QDialogButtonBox":
-		fallthrough
-	case "QFrame":
-		fallthrough
-	case "QLineEdit":
-		fallthrough
-	case "QLayout", "QFormLayout":
-		fallthrough
-	case "QAbstractItemView", "QProgressBar":
-		this.addImport("widgets")
-		return fmt.Sprintf("widgets.%s", strings.Replace(enum, ":", "_", -1))
-	}
-
-	log.Errorf("unknown enum %s", enum)
-	return ""
+func (this *compiler) addNamedImport(_import string, name string) {
+	this.Imports[_import] = name
 }
 
 func (this *compiler) defineFont() {
@@ -214,6 +241,17 @@ func (this *compiler) defineBrush() {
 	}
 }
 
+// defineGradient defines the variable varName of the gradient class, e.g.
+// QLinearGradient, once.
+func (this *compiler) defineGradient(varName string, class string) string {
+	if !this.DefinedGradients[varName] {
+		this.addImport("gui")
+		this.addSetupUICode(fmt.Sprintf("var %s *gui.%s", varName, class))
+		this.DefinedGradients[varName] = true
+	}
+	return varName
+}
+
 func (this *compiler) defineSizePolicy() {
 	if !this.SizePolicyDefined {
 		this.addImport("widgets")
@@ -254,22 +292,30 @@ func (this *compiler) defineButtonGroup(buttonGroupName string) string {
 
 	this.addImport("widgets")
 	this.addVariableCode(fmt.Sprintf("%s *widgets.QButtonGroup", varName))
-	this.addSetupUICode(fmt.Sprintf("this.%s = widgets.NewQButtonGroup(%s)", varName, this.RootWidgetName))
+	this.addSetupUICode(fmt.Sprintf("this.%s = %s(%s)", varName, this.constructor(Position{}, "QButtonGroup", "*core.QObject"), this.RootWidgetName))
 	this.addSetupUICode(fmt.Sprintf("this.%s.SetObjectName(\"%s\")", varName, buttonGroupName))
 	this.DefinedButtonGroups[varName] = true
 	return varName
 }
 
+// treeItemVarName returns the name of the i-th tree item variable, 1-based.
+func treeItemVarName(i int) string {
+	return fmt.Sprintf("treeItem%d", i)
+}
+
+// defineTreeItem returns an unused tree item variable, the one defined first
+// if several are free, so that the generated code is reproducible.
 func (this *compiler) defineTreeItem() string {
-	for k, v := range this.DefinedTreeItems {
-		if !v {
+	for i := 1; i <= len(this.DefinedTreeItems); i++ {
+		k := treeItemVarName(i)
+		if !this.DefinedTreeItems[k] {
 			this.DefinedTreeItems[k] = true
 			return k
 		}
 	}
 
 	this.addImport("widgets")
-	varName := fmt.Sprintf("treeItem%d", len(this.DefinedTreeItems)+1)
+	varName := treeItemVarName(len(this.DefinedTreeItems) + 1)
 	this.addSetupUICode(fmt.Sprintf("var %s *widgets.QTreeWidgetItem", varName))
 	this.DefinedTreeItems[varName] = true
 	return varName
@@ -293,76 +339,311 @@ func (this *compiler) defineSortingEnabled() {
 	}
 }
 
-func (this *compiler) setProperty(name string, prop *Property) {
-	this.setPropertyEx(name, "", prop)
+// translateString returns the _translate call of str. Like uic, the comment
+// is the disambiguation; the extra comment and id are written above the call
+// as lupdate meta comments. ui files carry no count, so n is always -1.
+func (this *compiler) translateString(str *String) string {
+	for _, line := range strings.Split(str.ExtraComment, "\n") {
+		if line != "" {
+			this.addTranslateCode("//: " + line)
+		}
+	}
+	if str.Id != "" {
+		this.addTranslateCode("//= " + str.Id)
+	}
+	return fmt.Sprintf("_translate(%s, %s, %s, -1)", strconv.Quote(this.RootWidgetName), strconv.Quote(str.Value), strconv.Quote(str.Comment))
 }
 
-func (this *compiler) translateIcon(icon *QIcon) {
-	this.defineIcon()
-	this.addImport("gui")
-	if icon.Theme != "" {
-		this.addSetupUICode(fmt.Sprintf("icon = gui.QIcon_FromTheme(\"%s\")", icon.Theme))
-	} else {
-		this.addImport("core")
-		this.addSetupUICode("icon = gui.NewQIcon()")
+// pixmapToString returns the expression loading pixmap.
+func (this *compiler) pixmapToString(pos Position, pixmap *QPixmap) string {
+	this.addImport("core")
+	this.checkResourcePath(pos, pixmap.Value)
+	return fmt.Sprintf("%s(%s, \"\", core.Qt__AutoColor)", this.constructor(pos, "QPixmap", "string", "string", anyEnum),
+		strconv.Quote(pixmap.Value))
+}
 
-		if icon.NormalOff != "" {
-			this.addSetupUICode(fmt.Sprintf("icon.AddPixmap(gui.NewQPixmap5(\"%s\", \"\", core.Qt__AutoColor), gui.QIcon__Normal, gui.QIcon__Off)", icon.NormalOff))
-		}
+// dateToString returns the expression creating the date.
+func (this *compiler) dateToString(pos Position, year, month, day int) string {
+	return fmt.Sprintf("%s(%d, %d, %d)", this.constructor(pos, "QDate", untypedInt, untypedInt, untypedInt), year, month, day)
+}
 
-		if icon.NormalOn != "" {
-			this.addSetupUICode(fmt.Sprintf("icon.AddPixmap(gui.NewQPixmap5(\"%s\", \"\", core.Qt__AutoColor), gui.QIcon__Normal, gui.QIcon__On)", icon.NormalOn))
-		}
+// timeToString returns the expression creating the time.
+func (this *compiler) timeToString(pos Position, hour, minute, second int) string {
+	return fmt.Sprintf("%s(%d, %d, %d, 0)", this.constructor(pos, "QTime", untypedInt, untypedInt, untypedInt, untypedInt),
+		hour, minute, second)
+}
 
-		if icon.DisabledOff != "" {
-			this.addSetupUICode(fmt.Sprintf("icon.AddPixmap(gui.NewQPixmap5(\"%s\", \"\", core.Qt__AutoColor), gui.QIcon__Disabled, gui.QIcon__Off)", icon.DisabledOff))
-		}
+// colorToString returns the expression creating color.
+func (this *compiler) colorToString(pos Position, color *QColor) string {
+	return fmt.Sprintf("%s(%d, %d, %d, %d)", this.constructor(pos, "QColor", untypedInt, untypedInt, untypedInt, untypedInt),
+		color.Red, color.Green, color.Blue, color.Alpha)
+}
+
+// pointToString returns the expression creating point.
+func (this *compiler) pointToString(pos Position, point *QPoint) string {
+	return fmt.Sprintf("%s(%d, %d)", this.constructor(pos, "QPoint", untypedInt, untypedInt), point.X, point.Y)
+}
+
+// pointFToString returns the expression creating point.
+func (this *compiler) pointFToString(pos Position, point *QPointF) string {
+	return fmt.Sprintf("%s(%f, %f)", this.constructor(pos, "QPointF", untypedFloat, untypedFloat), point.X, point.Y)
+}
+
+// sizeToString returns the expression creating size.
+func (this *compiler) sizeToString(pos Position, size *QSize) string {
+	return fmt.Sprintf("%s(%d, %d)", this.constructor(pos, "QSize", untypedInt, untypedInt), size.Width, size.Height)
+}
+
+// sizeFToString returns the expression creating size.
+func (this *compiler) sizeFToString(pos Position, size *QSizeF) string {
+	return fmt.Sprintf("%s(%f, %f)", this.constructor(pos, "QSizeF", untypedFloat, untypedFloat), size.Width, size.Height)
+}
+
+// rectToString returns the expression creating rect.
+func (this *compiler) rectToString(pos Position, rect *QRect) string {
+	return fmt.Sprintf("%s(%d, %d, %d, %d)", this.constructor(pos, "QRect", untypedInt, untypedInt, untypedInt, untypedInt),
+		rect.X, rect.Y, rect.Width, rect.Height)
+}
+
+// rectFToString returns the expression creating rect.
+func (this *compiler) rectFToString(pos Position, rect *QRectF) string {
+	return fmt.Sprintf("%s(%f, %f, %f, %f)", this.constructor(pos, "QRectF", untypedFloat, untypedFloat, untypedFloat, untypedFloat),
+		rect.X, rect.Y, rect.Width, rect.Height)
+}
+
+// localeToString returns the expression creating locale.
+func (this *compiler) localeToString(pos Position, locale *QLocale) string {
+	this.addImport("core")
+	return fmt.Sprintf("%s(core.QLocale__%s, core.QLocale__%s)", this.constructor(pos, "QLocale", anyEnum, anyEnum),
+		locale.Language, locale.Country)
+}
+
+// dateTimeToString returns the expression creating the local date time.
+func (this *compiler) dateTimeToString(pos Position, datetime *DateTime) string {
+	this.addImport("core")
+	return fmt.Sprintf("%s(%s, %s, core.Qt__LocalTime)",
+		this.constructor(pos, "QDateTime", "*core.QDate", "*core.QTime", anyEnum),
+		this.dateToString(pos, datetime.Year, datetime.Month, datetime.Day),
+		this.timeToString(pos, datetime.Hour, datetime.Minute, datetime.Second))
+}
 
-		if icon.DisabledOn != "" {
-			this.addSetupUICode(fmt.Sprintf("icon.AddPixmap(gui.NewQPixmap5(\"%s\", \"\", core.Qt__AutoColor), gui.QIcon__Disabled, gui.QIcon__On)", icon.DisabledOn))
+// charToString returns the expression creating char.
+func (this *compiler) charToString(pos Position, char *Char) string {
+	return fmt.Sprintf("%s(%d)", this.constructor(pos, "QChar", "int"), char.Unicode)
+}
+
+// urlToString returns the expression creating url.
+func (this *compiler) urlToString(pos Position, url *Url) string {
+	this.addImport("core")
+	return fmt.Sprintf("%s(%s, core.QUrl__TolerantMode)", this.constructor(pos, "QUrl", "string", anyEnum), strconv.Quote(url.String))
+}
+
+// setToString returns the expression or-ing the enums of set, or "" if one
+// of them is unknown.
+func (this *compiler) setToString(pos Position, set *Set) string {
+	enums := strings.Split(set.Value, "|")
+	enumStrings := make([]string, len(enums))
+	for i, enum := range enums {
+		enumStrings[i] = this.enumToString(pos, enum)
+		if enumStrings[i] == "" {
+			return ""
 		}
+	}
+	return strings.Join(enumStrings, " | ")
+}
 
-		if icon.ActiveOff != "" {
-			this.addSetupUICode(fmt.Sprintf("icon.AddPixmap(gui.NewQPixmap5(\"%s\", \"\", core.Qt__AutoColor), gui.QIcon__Active, gui.QIcon__Off)", icon.ActiveOff))
+// translateFont sets the font variable of the generated code to font.
+func (this *compiler) translateFont(pos Position, font *QFont) {
+	this.defineFont()
+	this.addSetupUICode(fmt.Sprintf("font = %s()", this.constructor(pos, "QFont")))
+	if font.Family != "" {
+		this.addSetupUICode(fmt.Sprintf("font.SetFamily(%s)", strconv.Quote(font.Family)))
+	}
+	if font.PointSize != nil {
+		this.addSetupUICode(fmt.Sprintf("font.SetPointSize(%d)", *font.PointSize))
+	}
+	if font.Bold != nil {
+		this.addSetupUICode(fmt.Sprintf("font.SetBold(%s)", boolToString(*font.Bold)))
+	}
+	if font.Italic != nil {
+		this.addSetupUICode(fmt.Sprintf("font.SetItalic(%s)", boolToString(*font.Italic)))
+	}
+	if font.Underline != nil {
+		this.addSetupUICode(fmt.Sprintf("font.SetUnderline(%s)", boolToString(*font.Underline)))
+	}
+	if font.Weight != nil {
+		this.addSetupUICode(fmt.Sprintf("font.SetWeight(%d)", *font.Weight))
+	}
+	if font.Strikeout != nil {
+		this.addSetupUICode(fmt.Sprintf("font.SetStrikeOut(%s)", boolToString(*font.Strikeout)))
+	}
+	if font.Kerning != nil {
+		this.addSetupUICode(fmt.Sprintf("font.SetKerning(%s)", boolToString(*font.Kerning)))
+	}
+	if font.AntiAliasing != nil {
+		// Like uic, antialiasing selects the default strategy or disables it.
+		strategy := iifs(*font.AntiAliasing, "QFont::PreferDefault", "QFont::NoAntialias")
+		this.addSetupUICode(fmt.Sprintf("font.SetStyleStrategy(%s)", this.enumToString(pos, strategy)))
+	}
+	if font.StyleStrategy != "" {
+		strategy := font.StyleStrategy
+		if !strings.Contains(strategy, "::") {
+			strategy = "QFont::" + strategy
+		}
+		if value := this.enumToString(pos, strategy); value != "" {
+			this.addSetupUICode(fmt.Sprintf("font.SetStyleStrategy(%s)", value))
 		}
+	}
+}
 
-		if icon.ActiveOff != "" {
-			this.addSetupUICode(fmt.Sprintf("icon.AddPixmap(gui.NewQPixmap5(\"%s\", \"\", core.Qt__AutoColor), gui.QIcon__Active, gui.QIcon__On)", icon.ActiveOn))
+// translateBrush sets the brush variable of the generated code to brush.
+// It returns false if the brush cannot be generated.
+func (this *compiler) translateBrush(pos Position, brush *QBrush) bool {
+	this.defineBrush()
+
+	switch {
+	case brush.Gradient != nil:
+		gradient := brush.Gradient
+		var varName string
+		switch gradient.Type {
+		case "LinearGradient":
+			varName = this.defineGradient("linearGradient", "QLinearGradient")
+			this.addSetupUICode(fmt.Sprintf("%s = %s(%f, %f, %f, %f)", varName,
+				this.constructor(pos, "QLinearGradient", untypedFloat, untypedFloat, untypedFloat, untypedFloat),
+				gradient.StartX, gradient.StartY, gradient.EndX, gradient.EndY))
+		case "RadialGradient":
+			varName = this.defineGradient("radialGradient", "QRadialGradient")
+			this.addSetupUICode(fmt.Sprintf("%s = %s(%f, %f, %f, %f, %f)", varName,
+				this.constructor(pos, "QRadialGradient", untypedFloat, untypedFloat, untypedFloat, untypedFloat, untypedFloat),
+				gradient.CentralX, gradient.CentralY, gradient.Radius, gradient.FocalX, gradient.FocalY))
+		case "ConicalGradient":
+			varName = this.defineGradient("conicalGradient", "QConicalGradient")
+			this.addSetupUICode(fmt.Sprintf("%s = %s(%f, %f, %f)", varName,
+				this.constructor(pos, "QConicalGradient", untypedFloat, untypedFloat, untypedFloat),
+				gradient.CentralX, gradient.CentralY, gradient.Angle))
+		default:
+			this.errorf(pos, "unknown gradient type %s", gradient.Type)
+			return false
 		}
 
-		if icon.SelectedOff != "" {
-			this.addSetupUICode(fmt.Sprintf("icon.AddPixmap(gui.NewQPixmap5(\"%s\", \"\", core.Qt__AutoColor), gui.QIcon__Selected, gui.QIcon__Off)", icon.SelectedOff))
+		if gradient.Spread != "" {
+			if value := this.enumToString(pos, "QGradient::"+gradient.Spread); value != "" {
+				this.addSetupUICode(fmt.Sprintf("%s.SetSpread(%s)", varName, value))
+			}
+		}
+		if gradient.CoordinateMode != "" {
+			if value := this.enumToString(pos, "QGradient::"+gradient.CoordinateMode); value != "" {
+				this.addSetupUICode(fmt.Sprintf("%s.SetCoordinateMode(%s)", varName, value))
+			}
 		}
+		for _, stop := range gradient.GradientStops {
+			if len(stop.Colors) != 1 {
+				this.errorf(pos, "bad gradient stop with %d colors", len(stop.Colors))
+				continue
+			}
+			color := stop.Colors[0]
+			this.addSetupUICode(fmt.Sprintf("%s.SetColorAt(%f, %s)", varName, stop.Position, this.colorToString(pos, color)))
+		}
+		this.addSetupUICode(fmt.Sprintf("brush = %s(%s)", this.constructor(pos, "QBrush", "*gui.Q"+gradient.Type), varName))
+	case brush.Texture != nil:
+		pixmap, ok := brush.Texture.Value.(*QPixmap)
+		if !ok {
+			this.errorf(pos, "texture brush without pixmap")
+			return false
+		}
+		this.addSetupUICode(fmt.Sprintf("brush = %s(%s)", this.constructor(pos, "QBrush", "*gui.QPixmap"), this.pixmapToString(pos, pixmap)))
+	case brush.Color != nil:
+		this.addImport("core")
+		this.addSetupUICode(fmt.Sprintf("brush = %s(%s, core.Qt__%s)", this.constructor(pos, "QBrush", "*gui.QColor", anyEnum),
+			this.colorToString(pos, brush.Color),
+			brush.BrushStyle))
+	default:
+		this.errorf(pos, "empty brush")
+		return false
+	}
+	return true
+}
+
+func (this *compiler) setProperty(class string, name string, prop *Property) {
+	this.setPropertyEx(class, name, "", prop)
+}
 
-		if icon.SelectedOn != "" {
-			this.addSetupUICode(fmt.Sprintf("icon.AddPixmap(gui.NewQPixmap5(\"%s\", \"\", core.Qt__AutoColor), gui.QIcon__Selected, gui.QIcon__On)", icon.SelectedOn))
+func (this *compiler) translateIcon(pos Position, icon *QIcon) {
+	this.defineIcon()
+	this.addImport("gui")
+	if icon.Theme != "" {
+		this.addSetupUICode(fmt.Sprintf("icon = gui.QIcon_FromTheme(\"%s\")", icon.Theme))
+	} else {
+		this.addImport("core")
+		this.addSetupUICode(fmt.Sprintf("icon = %s()", this.constructor(pos, "QIcon")))
+
+		states := []struct {
+			file, mode, state string
+		}{
+			{icon.NormalOff, "Normal", "Off"},
+			{icon.NormalOn, "Normal", "On"},
+			{icon.DisabledOff, "Disabled", "Off"},
+			{icon.DisabledOn, "Disabled", "On"},
+			{icon.ActiveOff, "Active", "Off"},
+			{icon.ActiveOn, "Active", "On"},
+			{icon.SelectedOff, "Selected", "Off"},
+			{icon.SelectedOn, "Selected", "On"},
+		}
+		for _, st := range states {
+			if st.file != "" {
+				pixmap := this.pixmapToString(pos, &QPixmap{Value: st.file})
+				this.addSetupUICode(fmt.Sprintf("icon.%s(%s, gui.QIcon__%s, gui.QIcon__%s)",
+					this.overload(pos, "QIcon", "addPixmap", "*gui.QPixmap", anyEnum, anyEnum), pixmap, st.mode, st.state))
+			}
 		}
 	}
 }
 
-func (this *compiler) setPropertyEx(name string, paramPrefix string, prop *Property) {
+func (this *compiler) setPropertyEx(class string, name string, paramPrefix string, prop *Property) {
+	// paramPrefix is the column argument of tree widget item setters.
+	setter := func(argType string) string {
+		if paramPrefix != "" {
+			return this.setter(prop.Pos, class, prop.Name, untypedInt, argType)
+		}
+		return this.setter(prop.Pos, class, prop.Name, argType)
+	}
+
+	switch kind, ok := this.propertyKind(class, prop); {
+	case !ok:
+		this.errorf(prop.Pos, "unknown property %s of %s", prop.Name, class)
+		return
+	case kind == ReadOnly:
+		this.errorf(prop.Pos, "property %s of %s is read-only", prop.Name, class)
+		return
+	case kind == DesignerOnly:
+		return
+	case kind == Dynamic:
+		this.setDynamicProperty(class, name, prop)
+		return
+	}
+
 	var valueStr string
 	switch prop.Value.(type) {
 	case bool:
 		v, _ := prop.Value.(bool)
 		valueStr = boolToString(v)
-		this.addSetupUICode(fmt.Sprintf("%s.Set%s(%s%s)", name, this.toCamelCase(prop.Name), paramPrefix, valueStr))
+		this.addSetupUICode(fmt.Sprintf("%s.%s(%s%s)", name, setter("bool"), paramPrefix, valueStr))
 	case *QColor:
 		color := prop.Value.(*QColor)
 		this.addImport("gui")
-		valueStr = fmt.Sprintf("gui.NewQColor3(%d, %d, %d, %d)",
-			color.Red,
-			color.Green,
-			color.Blue,
-			color.Alpha)
-		this.addSetupUICode(fmt.Sprintf("%s.Set%s(%s%s)", name, this.toCamelCase(prop.Name), paramPrefix, valueStr))
+		valueStr = this.colorToString(prop.Pos, color)
+		this.addSetupUICode(fmt.Sprintf("%s.%s(%s%s)", name, setter("*gui.QColor"), paramPrefix, valueStr))
 	case string:
 		switch prop.Name {
 		case "buddy":
 			valueStr, _ := prop.Value.(string)
-			this.addBuddyCode(fmt.Sprintf("%s.Set%s(%sthis.%s)", name, this.toCamelCase(prop.Name), paramPrefix, this.transVarName(valueStr)))
+			buddyType, err := this.goType(this.objectClass(valueStr))
+			if err != nil {
+				buddyType = "*widgets.QWidget"
+			}
+			this.addBuddyCode(fmt.Sprintf("%s.%s(%sthis.%s)", name, setter(buddyType), paramPrefix, this.transVarName(valueStr)))
 		default:
-			log.Errorf("cstring property %s not supported", prop.Name)
+			this.errorf(prop.Pos, "cstring property %s not supported", prop.Name)
 		}
 	case *Cursor:
 	// TODO:
@@ -370,75 +651,47 @@ func (this *compiler) setPropertyEx(name string, paramPrefix string, prop *Prope
 		cursorShape, _ := prop.Value.(*CursorShape)
 		this.addImport("core")
 		this.addImport("gui")
-		valueStr = fmt.Sprintf("gui.NewQCursor2(core.Qt__%s)", cursorShape.Value)
-		this.addSetupUICode(fmt.Sprintf("%s.Set%s(%s%s)", name, this.toCamelCase(prop.Name), paramPrefix, valueStr))
+		valueStr = fmt.Sprintf("%s(core.Qt__%s)", this.constructor(prop.Pos, "QCursor", anyEnum), cursorShape.Value)
+		this.addSetupUICode(fmt.Sprintf("%s.%s(%s%s)", name, setter("*gui.QCursor"), paramPrefix, valueStr))
 	case *Enum:
 		enum, _ := prop.Value.(*Enum)
-		valueStr = this.enumToString(enum.Value)
-		this.addSetupUICode(fmt.Sprintf("%s.Set%s(%s%s)", name, this.toCamelCase(prop.Name), paramPrefix, valueStr))
-	case *QFont:
-		this.defineFont()
-		this.addSetupUICode("font = gui.NewQFont()")
-		font := prop.Value.(*QFont)
-		if font.Family != "" {
-			this.addSetupUICode(fmt.Sprintf("font.SetFamily(\"%s\")", font.Family))
-		}
-		if font.PointSize != 0 {
-			this.addSetupUICode(fmt.Sprintf("font.SetPointSize(%d)", font.PointSize))
-		}
-		if font.Weight != 0 {
-			this.addSetupUICode(fmt.Sprintf("font.SetWeight(%d)", font.Weight))
-		}
-		if font.Italic {
-			this.addSetupUICode(fmt.Sprintf("font.SetItalic(true)"))
-		}
-		if font.Bold {
-			this.addSetupUICode(fmt.Sprintf("font.SetBold(true)"))
-		}
-		if font.Underline {
-			this.addSetupUICode(fmt.Sprintf("font.SetUnderline(true)"))
-		}
-		if font.Strikeout {
-			this.addSetupUICode(fmt.Sprintf("font.SetStrikeout(true)"))
+		valueStr = this.enumToString(prop.Pos, enum.Value)
+		if valueStr == "" {
+			return
 		}
-		if font.Kerning {
-			this.addSetupUICode(fmt.Sprintf("font.SetKerning(true)"))
-		}
-		if font.StyleStrategy != "" {
-			this.addSetupUICode(fmt.Sprintf("font.SetStyleStrategy(gui.%s)", strings.Replace(font.StyleStrategy, ":", "_", -1)))
-		}
-		this.addSetupUICode(fmt.Sprintf("%s.Set%s(%sfont)", name, this.toCamelCase(prop.Name), paramPrefix))
+		this.addSetupUICode(fmt.Sprintf("%s.%s(%s%s)", name, setter(anyEnum), paramPrefix, valueStr))
+	case *QFont:
+		this.translateFont(prop.Pos, prop.Value.(*QFont))
+		this.addSetupUICode(fmt.Sprintf("%s.%s(%sfont)", name, setter("*gui.QFont"), paramPrefix))
 	case *QPixmap:
 		this.addImport("gui")
 		this.addImport("core")
 		pixmap := prop.Value.(*QPixmap)
-		this.addSetupUICode(fmt.Sprintf("%s.Set%s(gui.NewQPixmap5(%s\"%s\", \"\", core.Qt__AutoColor))", name, this.toCamelCase(prop.Name), paramPrefix, pixmap.Value))
+		this.addSetupUICode(fmt.Sprintf("%s.%s(%s%s)", name, setter("*gui.QPixmap"), paramPrefix, this.pixmapToString(prop.Pos, pixmap)))
 	case *QIcon:
 		icon := prop.Value.(*QIcon)
-		this.translateIcon(icon)
-		this.addSetupUICode(fmt.Sprintf("%s.Set%s(%sicon)", name, this.toCamelCase(prop.Name), paramPrefix))
+		this.translateIcon(prop.Pos, icon)
+		this.addSetupUICode(fmt.Sprintf("%s.%s(%sicon)", name, setter("*gui.QIcon"), paramPrefix))
 	case *QPalette:
 		palette := prop.Value.(*QPalette)
 		this.definePalette()
 		this.defineBrush()
 		this.addImport("core")
 		this.addImport("gui")
-		this.addSetupUICode(fmt.Sprintf("palette = gui.NewQPalette()"))
+		this.addSetupUICode(fmt.Sprintf("palette = %s()", this.constructor(prop.Pos, "QPalette")))
 		setPalette := func(groupName string, colorGroup *ColorGroup) {
 			for _, item := range colorGroup.Items {
 				if item.IsColor {
-					log.Error("Color role required for palette")
+					this.errorf(prop.Pos, "color role required for palette")
 					continue
 				}
 
 				colorRole := item.ColorRole
-				brush := colorRole.Brush
-				this.addSetupUICode(fmt.Sprintf("brush = gui.NewQBrush3(gui.NewQColor3(%d, %d, %d, %d), core.Qt__%s)", brush.Color.Red,
-					brush.Color.Green,
-					brush.Color.Blue,
-					brush.Color.Alpha,
-					brush.BrushStyle))
-				this.addSetupUICode(fmt.Sprintf("palette.SetBrush2(gui.QPalette__%s, gui.QPalette__%s, brush)", groupName, colorRole.Role))
+				if !this.translateBrush(prop.Pos, colorRole.Brush) {
+					continue
+				}
+				this.addSetupUICode(fmt.Sprintf("palette.%s(gui.QPalette__%s, gui.QPalette__%s, brush)",
+					this.overload(prop.Pos, "QPalette", "setBrush", anyEnum, anyEnum, "*gui.QBrush"), groupName, colorRole.Role))
 			}
 		}
 		if palette.Active != nil {
@@ -452,138 +705,135 @@ func (this *compiler) setPropertyEx(name string, paramPrefix string, prop *Prope
 		if palette.Disabled != nil {
 			setPalette("Disabled", palette.Disabled)
 		}
-		this.addSetupUICode(fmt.Sprintf("%s.Set%s(%spalette)", name, this.toCamelCase(prop.Name), paramPrefix))
+		this.addSetupUICode(fmt.Sprintf("%s.%s(%spalette)", name, setter("*gui.QPalette"), paramPrefix))
 
 	case *QPoint:
 		point := prop.Value.(*QPoint)
 		this.addImport("core")
-		valueStr = fmt.Sprintf("core.NewQPoint2(%d, %d)", point.X, point.Y)
-		this.addSetupUICode(fmt.Sprintf("%s.Set%s(%s%s)", name, this.toCamelCase(prop.Name), paramPrefix, valueStr))
+		valueStr = this.pointToString(prop.Pos, point)
+		this.addSetupUICode(fmt.Sprintf("%s.%s(%s%s)", name, setter("*core.QPoint"), paramPrefix, valueStr))
 	case *QRect:
 		rect := prop.Value.(*QRect)
 		this.addImport("core")
-		valueStr = fmt.Sprintf("core.NewQRect4(%d, %d, %d, %d)", rect.X, rect.Y, rect.Width, rect.Height)
-		this.addSetupUICode(fmt.Sprintf("%s.Set%s(%s%s)", name, this.toCamelCase(prop.Name), paramPrefix, valueStr))
+		valueStr = this.rectToString(prop.Pos, rect)
+		this.addSetupUICode(fmt.Sprintf("%s.%s(%s%s)", name, setter("*core.QRect"), paramPrefix, valueStr))
 	case *Set:
-		set := prop.Value.(*Set)
-		enums := strings.Split(set.Value, "|")
-		enumStrings := make([]string, len(enums))
-		for i, enum := range enums {
-			enumStrings[i] = this.enumToString(enum)
-		}
-		valueStr = strings.Join(enumStrings, " | ")
-		this.addSetupUICode(fmt.Sprintf("%s.Set%s(%s%s)", name, this.toCamelCase(prop.Name), paramPrefix, valueStr))
+		valueStr = this.setToString(prop.Pos, prop.Value.(*Set))
+		if valueStr == "" {
+			return
+		}
+		this.addSetupUICode(fmt.Sprintf("%s.%s(%s%s)", name, setter(anyEnum), paramPrefix, valueStr))
 	case *QLocale:
-		locale := prop.Value.(*QLocale)
-		this.addImport("core")
-		valueStr = fmt.Sprintf("core.NewQLocale3(core.QLocale__%s, core.QLocale__%s)", locale.Language, locale.Country)
-		this.addSetupUICode(fmt.Sprintf("%s.Set%s(%s%s)", name, this.toCamelCase(prop.Name), paramPrefix, valueStr))
+		valueStr = this.localeToString(prop.Pos, prop.Value.(*QLocale))
+		this.addSetupUICode(fmt.Sprintf("%s.%s(%s%s)", name, setter("*core.QLocale"), paramPrefix, valueStr))
 	case *QSizePolicy:
 		sizePolicy := prop.Value.(*QSizePolicy)
 		this.defineSizePolicy()
-		this.addSetupUICode(fmt.Sprintf("sizePolicy = widgets.NewQSizePolicy2(widgets.QSizePolicy__%s, widgets.QSizePolicy__%s, widgets.QSizePolicy__DefaultType)",
-			sizePolicy.HSizeType, sizePolicy.VSizeType))
+		this.addSetupUICode(fmt.Sprintf("sizePolicy = %s(widgets.QSizePolicy__%s, widgets.QSizePolicy__%s, widgets.QSizePolicy__DefaultType)",
+			this.constructor(prop.Pos, "QSizePolicy", anyEnum, anyEnum, anyEnum), sizePolicy.HSizeType, sizePolicy.VSizeType))
 		this.addSetupUICode(fmt.Sprintf("sizePolicy.SetHorizontalStretch(%d)", sizePolicy.HorStretch))
 		this.addSetupUICode(fmt.Sprintf("sizePolicy.SetVerticalStretch(%d)", sizePolicy.VerStretch))
 		this.addSetupUICode(fmt.Sprintf("sizePolicy.SetHeightForWidth(%s.SizePolicy().HasHeightForWidth())", name))
-		this.addSetupUICode(fmt.Sprintf("%s.Set%s(%ssizePolicy)", name, this.toCamelCase(prop.Name), paramPrefix))
+		this.addSetupUICode(fmt.Sprintf("%s.%s(%ssizePolicy)", name, setter("*widgets.QSizePolicy"), paramPrefix))
 	case *QSize:
 		size := prop.Value.(*QSize)
 		this.addImport("core")
-		valueStr = fmt.Sprintf("core.NewQSize2(%d, %d)", size.Width, size.Height)
-		this.addSetupUICode(fmt.Sprintf("%s.Set%s(%s%s)", name, this.toCamelCase(prop.Name), paramPrefix, valueStr))
+		valueStr = this.sizeToString(prop.Pos, size)
+		this.addSetupUICode(fmt.Sprintf("%s.%s(%s%s)", name, setter("*core.QSize"), paramPrefix, valueStr))
 	case *String:
 		str := prop.Value.(*String)
 		if !str.NotR {
-			this.addTranslateCode(fmt.Sprintf("%s.Set%s(_translate(\"%s\", %s, \"\", -1))", name, this.toCamelCase(prop.Name), this.RootWidgetName, strconv.Quote(str.Value)))
+			this.addTranslateCode(fmt.Sprintf("%s.%s(%s)", name, setter("string"), this.translateString(str)))
 		} else {
-			this.addSetupUICode(fmt.Sprintf("%s.Set%s(%s%s)", name, this.toCamelCase(prop.Name), paramPrefix, strconv.Quote(str.Value)))
+			this.addSetupUICode(fmt.Sprintf("%s.%s(%s%s)", name, setter("string"), paramPrefix, strconv.Quote(str.Value)))
 		}
 	case *StringList:
-		log.Errorf("string list prop %s not supported", prop.Name)
+		list := prop.Value.(*StringList)
+		code := fmt.Sprintf("%s.%s(%s%s)", name, setter("[]string"), paramPrefix, this.stringListToString(list))
+		if !list.NotR {
+			this.addTranslateCode(code)
+		} else {
+			this.addSetupUICode(code)
+		}
 	case int:
 		valueStr = fmt.Sprintf("%d", prop.Value)
-		this.addSetupUICode(fmt.Sprintf("%s.Set%s(%s%s)", name, this.toCamelCase(prop.Name), paramPrefix, valueStr))
+		this.addSetupUICode(fmt.Sprintf("%s.%s(%s%s)", name, setter(untypedInt), paramPrefix, valueStr))
 	case float32:
 		valueStr = fmt.Sprintf("%f", prop.Value)
-		this.addSetupUICode(fmt.Sprintf("%s.Set%s(%s%s)", name, this.toCamelCase(prop.Name), paramPrefix, valueStr))
+		this.addSetupUICode(fmt.Sprintf("%s.%s(%s%s)", name, setter(untypedFloat), paramPrefix, valueStr))
 	case float64:
 		valueStr = fmt.Sprintf("%f", prop.Value)
-		this.addSetupUICode(fmt.Sprintf("%s.Set%s(%s%s)", name, this.toCamelCase(prop.Name), paramPrefix, valueStr))
+		this.addSetupUICode(fmt.Sprintf("%s.%s(%s%s)", name, setter(untypedFloat), paramPrefix, valueStr))
 	case *Date:
 		date := prop.Value.(*Date)
 		this.addImport("core")
-		valueStr = fmt.Sprintf("core.NewQDate3(%d, %d, %d)", date.Year, date.Month, date.Day)
-		this.addSetupUICode(fmt.Sprintf("%s.Set%s(%s%s)", name, this.toCamelCase(prop.Name), paramPrefix, valueStr))
+		valueStr = this.dateToString(prop.Pos, date.Year, date.Month, date.Day)
+		this.addSetupUICode(fmt.Sprintf("%s.%s(%s%s)", name, setter("*core.QDate"), paramPrefix, valueStr))
 	case *Time:
 		time := prop.Value.(*Time)
 		this.addImport("core")
-		valueStr = fmt.Sprintf("core.NewQTime3(%d, %d, %d, 0)", time.Hour, time.Minute, time.Second)
-		this.addSetupUICode(fmt.Sprintf("%s.Set%s(%s%s)", name, this.toCamelCase(prop.Name), paramPrefix, valueStr))
+		valueStr = this.timeToString(prop.Pos, time.Hour, time.Minute, time.Second)
+		this.addSetupUICode(fmt.Sprintf("%s.%s(%s%s)", name, setter("*core.QTime"), paramPrefix, valueStr))
 	case *DateTime:
-		datetime := prop.Value.(*DateTime)
-		this.addImport("core")
-		valueStr = fmt.Sprintf("core.NewQDateTime3(core.NewQDate3(%d, %d, %d), core.NewQTime3(%d, %d, %d, 0), core.Qt__LocalTime)",
-			datetime.Year, datetime.Month, datetime.Day,
-			datetime.Hour, datetime.Minute, datetime.Second)
-		this.addSetupUICode(fmt.Sprintf("%s.Set%s(%s%s)", name, this.toCamelCase(prop.Name), paramPrefix, valueStr))
+		valueStr = this.dateTimeToString(prop.Pos, prop.Value.(*DateTime))
+		this.addSetupUICode(fmt.Sprintf("%s.%s(%s%s)", name, setter("*core.QDateTime"), paramPrefix, valueStr))
 	case *QPointF:
 		point := prop.Value.(*QPointF)
 		this.addImport("core")
-		valueStr = fmt.Sprintf("core.NewQPointF2(%d, %d)", point.X, point.Y)
-		this.addSetupUICode(fmt.Sprintf("%s.Set%s(%s%s)", name, this.toCamelCase(prop.Name), paramPrefix, valueStr))
+		valueStr = this.pointFToString(prop.Pos, point)
+		this.addSetupUICode(fmt.Sprintf("%s.%s(%s%s)", name, setter("*core.QPointF"), paramPrefix, valueStr))
 	case *QRectF:
 		rect := prop.Value.(*QRectF)
 		this.addImport("core")
-		valueStr = fmt.Sprintf("core.NewQRect4(%f, %f, %f, %f)", rect.X, rect.Y, rect.Width, rect.Height)
-		this.addSetupUICode(fmt.Sprintf("%s.Set%s(%s%s)", name, this.toCamelCase(prop.Name), paramPrefix, valueStr))
+		valueStr = this.rectFToString(prop.Pos, rect)
+		this.addSetupUICode(fmt.Sprintf("%s.%s(%s%s)", name, setter("*core.QRectF"), paramPrefix, valueStr))
 	case *QSizeF:
 		size := prop.Value.(*QSizeF)
 		this.addImport("core")
-		valueStr = fmt.Sprintf("core.NewQSize2(%f, %f)", size.Width, size.Height)
-		this.addSetupUICode(fmt.Sprintf("%s.Set%s(%s%s)", name, this.toCamelCase(prop.Name), paramPrefix, valueStr))
+		valueStr = this.sizeFToString(prop.Pos, size)
+		this.addSetupUICode(fmt.Sprintf("%s.%s(%s%s)", name, setter("*core.QSizeF"), paramPrefix, valueStr))
 	case int64:
 		valueStr = fmt.Sprintf("%d", prop.Value)
-		this.addSetupUICode(fmt.Sprintf("%s.Set%s(%s%s)", name, this.toCamelCase(prop.Name), paramPrefix, valueStr))
+		this.addSetupUICode(fmt.Sprintf("%s.%s(%s%s)", name, setter(untypedInt), paramPrefix, valueStr))
 	case *Char:
-		log.Errorf("char prop %s not supported", prop.Name)
+		// therecipe passes QChar arguments as *core.QChar, not as runes.
+		valueStr = this.charToString(prop.Pos, prop.Value.(*Char))
+		this.addSetupUICode(fmt.Sprintf("%s.%s(%s%s)", name, setter("*core.QChar"), paramPrefix, valueStr))
 	case *Url:
-		log.Errorf("url prop %s not supported", prop.Name)
+		valueStr = this.urlToString(prop.Pos, prop.Value.(*Url))
+		this.addSetupUICode(fmt.Sprintf("%s.%s(%s%s)", name, setter("*core.QUrl"), paramPrefix, valueStr))
 	case uint64:
 		valueStr = fmt.Sprintf("%d", prop.Value)
-		this.addSetupUICode(fmt.Sprintf("%s.Set%s(%s%s)", name, this.toCamelCase(prop.Name), paramPrefix, valueStr))
+		this.addSetupUICode(fmt.Sprintf("%s.%s(%s%s)", name, setter(untypedInt), paramPrefix, valueStr))
 	case *QBrush:
 		brush := prop.Value.(*QBrush)
-		if brush.Color != nil {
-			this.defineBrush()
-			this.addImport("core")
-			this.addSetupUICode(fmt.Sprintf("brush = gui.NewQBrush3(gui.NewQColor3(%d, %d, %d, %d), core.Qt__%s)", brush.Color.Red,
-				brush.Color.Green,
-				brush.Color.Blue,
-				brush.Color.Alpha,
-				brush.BrushStyle))
-			this.addSetupUICode(fmt.Sprintf("%s.Set%s(%sbrush)", name, this.toCamelCase(prop.Name), paramPrefix))
-		} else if brush.Gradient != nil {
-			//TODO:
-
-		} else if brush.Texture != nil {
-			//TODO:
+		if this.translateBrush(prop.Pos, brush) {
+			this.addSetupUICode(fmt.Sprintf("%s.%s(%sbrush)", name, setter("*gui.QBrush"), paramPrefix))
 		}
 	}
 }
 
-func (this *compiler) setProperties(name string, props []*Property) {
+func (this *compiler) setProperties(class string, name string, props []*Property) {
 	for _, prop := range props {
-		this.setProperty(name, prop)
+		this.setProperty(class, name, prop)
 	}
 }
 
+// getImports returns the imports sorted by path.
 func (this *compiler) getImports(indent string) string {
-	imports := make([]string, len(this.Imports))
-	i := 0
-	for s, _ := range this.Imports {
-		imports[i] = fmt.Sprintf("%s\"github.com/therecipe/qt/%s\"", indent, s)
-		i++
+	paths := make([]string, 0, len(this.Imports))
+	for s := range this.Imports {
+		paths = append(paths, s)
+	}
+	sort.Strings(paths)
+
+	imports := make([]string, len(paths))
+	for i, s := range paths {
+		if name := this.Imports[s]; name != "" {
+			imports[i] = fmt.Sprintf("%s%s \"%s\"", indent, name, s)
+		} else {
+			imports[i] = fmt.Sprintf("%s\"%s\"", indent, s)
+		}
 	}
 	return strings.Join(imports, "\n")
 }
@@ -621,14 +871,14 @@ func (this *compiler) getSetCurrentIndexCodes(indent string) string {
 }
 
 func (this *compiler) getClassName() string {
-	widgetName := this.widget.Name
+	widgetName := this.Widget.Name
 	switch widgetName {
 	case "Form":
 		fallthrough
 	case "Dialog":
 		fallthrough
 	case "MainWindow":
-		baseName := filepath.Base(this.uiFile)
+		baseName := filepath.Base(this.File)
 		xName := filepath.Ext(baseName)
 		mainName := baseName[:len(baseName)-len(xName)]
 		return strings.Replace(fmt.Sprintf("%s%s", ToCamelCase(mainName), widgetName), "_", "", -1)
@@ -661,16 +911,19 @@ func (this *compiler) translateSpacer(parentName string, spacer *QSpacer) {
 	}
 
 	this.addVariableCode(fmt.Sprintf("%s *widgets.QSpacerItem", spacerName))
-	this.addSetupUICode(fmt.Sprintf("this.%s = widgets.NewQSpacerItem(%d, %d, %s, %s)", spacerName, w, h, hPolicy, vPolicy))
+	this.addSetupUICode(fmt.Sprintf("this.%s = %s(%d, %d, %s, %s)", spacerName,
+		this.constructor(spacer.Pos, "QSpacerItem", untypedInt, untypedInt, anyEnum, anyEnum), w, h, hPolicy, vPolicy))
 }
 
-func (this *compiler) translateLayoutItem(parentWidgetName, parentName string, parentClass string, item *QLayoutItem) {
+// translateLayoutItem adds item to the layout parentName. stretch is the
+// stretch factor of the item in a box layout.
+func (this *compiler) translateLayoutItem(parentWidgetName, parentName string, parentClass string, item *QLayoutItem, stretch int) {
 	var childType string
 	var childName string
 	switch item.View.(type) {
 	case *QLayout:
 		layout, _ := item.View.(*QLayout)
-		this.translateLayout(parentWidgetName, layout)
+		this.translateLayout(parentWidgetName, layout, true)
 		childType = "Layout"
 		childName = this.transVarName(layout.Name)
 	case *QSpacer:
@@ -685,22 +938,44 @@ func (this *compiler) translateLayoutItem(parentWidgetName, parentName string, p
 		childName = this.transVarName(widget.Name)
 	}
 
+	childGoType := "*widgets.Q" + iifs(childType == "Item", "LayoutItem", childType)
+
+	// alignment is passed to the add call if it takes one, else set with
+	// setAlignment afterwards.
+	alignment, alignmentType := "0", untypedInt
+	if item.Alignment != "" {
+		if value := this.setToString(item.Pos, &Set{Value: item.Alignment}); value != "" {
+			alignment, alignmentType = value, anyEnum
+		}
+	}
+	alignmentSet := false
+
 	switch parentClass {
 	case "QVBoxLayout":
 		fallthrough
 	case "QHBoxLayout":
 		switch childType {
 		case "Layout":
-			this.addSetupUICode(fmt.Sprintf("%s.AddLayout(this.%s, 0)", parentName, childName))
+			this.addSetupUICode(fmt.Sprintf("%s.%s(this.%s, %d)", parentName,
+				this.overload(item.Pos, parentClass, "addLayout", childGoType, untypedInt), childName, stretch))
 		case "Item":
-			this.addSetupUICode(fmt.Sprintf("%s.AddItem(this.%s)", parentName, childName))
+			// addItem takes no stretch, translateLayout sets it afterwards.
+			this.addSetupUICode(fmt.Sprintf("%s.%s(this.%s)", parentName,
+				this.overload(item.Pos, parentClass, "addItem", childGoType), childName))
 		case "Widget":
-			this.addSetupUICode(fmt.Sprintf("%s.AddWidget(this.%s, 0, 0)", parentName, childName))
+			this.addSetupUICode(fmt.Sprintf("%s.%s(this.%s, %d, %s)", parentName,
+				this.overload(item.Pos, parentClass, "addWidget", childGoType, untypedInt, alignmentType), childName, stretch, alignment))
+			alignmentSet = true
 		}
 	case "QFormLayout":
 		this.addImport("widgets")
 		role := iifs(item.Column == 0, "widgets.QFormLayout__LabelRole", "widgets.QFormLayout__FieldRole")
-		this.addSetupUICode(fmt.Sprintf("%s.Set%s(%d, %s, this.%s)", parentName, childType, item.Row, role, childName))
+		if item.Colspan > 1 {
+			// Like uic, an item spanning both columns takes the whole row.
+			role = "widgets.QFormLayout__SpanningRole"
+		}
+		this.addSetupUICode(fmt.Sprintf("%s.%s(%d, %s, this.%s)", parentName,
+			this.overload(item.Pos, parentClass, "set"+childType, untypedInt, anyEnum, childGoType), item.Row, role, childName))
 	case "QGridLayout":
 		rowSpan := item.Rowspan
 		colSpan := item.Colspan
@@ -710,69 +985,110 @@ func (this *compiler) translateLayoutItem(parentWidgetName, parentName string, p
 		if colSpan == 0 {
 			colSpan = 1
 		}
-		switch childType {
-		case "Widget":
-			this.addSetupUICode(fmt.Sprintf("%s.Add%s3(this.%s, %d, %d, %d, %d, 0)", parentName, childType, childName, item.Row, item.Column, rowSpan, colSpan))
-		case "Layout":
-			this.addSetupUICode(fmt.Sprintf("%s.Add%s2(this.%s, %d, %d, %d, %d, 0)", parentName, childType, childName, item.Row, item.Column, rowSpan, colSpan))
-		default:
-			log.Errorf("QGridLayout.AddItem not support now, QLayout.AddItem used")
-			this.addSetupUICode(fmt.Sprintf("%s.Add%s(this.%s)", parentName, childType, childName))
+		this.addSetupUICode(fmt.Sprintf("%s.%s(this.%s, %d, %d, %d, %d, %s)", parentName,
+			this.overload(item.Pos, parentClass, "add"+childType, childGoType, untypedInt, untypedInt, untypedInt, untypedInt, alignmentType),
+			childName, item.Row, item.Column, rowSpan, colSpan, alignment))
+		alignmentSet = true
+	}
+
+	if item.Alignment != "" && !alignmentSet {
+		if childType == "Item" {
+			this.addSetupUICode(fmt.Sprintf("this.%s.SetAlignment(%s)", childName, alignment))
+		} else {
+			this.addSetupUICode(fmt.Sprintf("%s.%s(this.%s, %s)", parentName,
+				this.overload(item.Pos, parentClass, "setAlignment", childGoType, anyEnum), childName, alignment))
 		}
 	}
 }
 
-func (this *compiler) translateLayout(parentName string, layout *QLayout) {
+// translateLayout creates layout of the widget parentName. A nested layout
+// belongs to a parent layout instead; it is added by translateLayoutItem.
+//
+// As with uic, margins and spacing not set in the ui file are taken from
+// <layoutfunction> or <layoutDefault>, except that nested layouts have no
+// margins. Without either, the style defaults are kept.
+func (this *compiler) translateLayout(parentName string, layout *QLayout, nested bool) {
 	layoutName := this.transVarName(layout.Name)
 	this.addImport("widgets")
 	this.addVariableCode(fmt.Sprintf("%s *widgets.%s", layoutName, layout.Class))
-	switch layout.Class {
-	case "QVBoxLayout":
-		fallthrough
-	case "QHBoxLayout":
-		this.addSetupUICode(fmt.Sprintf("this.%s = widgets.New%s2(%s)", layoutName, layout.Class, parentName))
-	default:
-		this.addSetupUICode(fmt.Sprintf("this.%s = widgets.New%s(%s)", layoutName, layout.Class, parentName))
+	if nested {
+		this.addSetupUICode(fmt.Sprintf("this.%s = %s(nil)", layoutName, this.constructor(layout.Pos, layout.Class, untypedNil)))
+	} else {
+		this.addSetupUICode(fmt.Sprintf("this.%s = %s(%s)", layoutName, this.constructor(layout.Pos, layout.Class, "*widgets.QWidget"), parentName))
 	}
 	this.addSetupUICode(fmt.Sprintf("this.%s.SetObjectName(\"%s\")", layoutName, layout.Name))
 
-	leftMargin, rightMargin, topMargin, bottomMargin := 0, 0, 0, 0
-	spacing := 0
-	if this.layoutDefault != nil {
-		leftMargin, rightMargin, topMargin, bottomMargin = this.layoutDefault.Margin, this.layoutDefault.Margin,
-			this.layoutDefault.Margin, this.layoutDefault.Margin
-		spacing = this.layoutDefault.Spacing
+	// The defaults are expressions, "" to keep the style default.
+	defaultMargin, defaultSpacing := "", ""
+	if this.LayoutDefault != nil {
+		defaultMargin, defaultSpacing = strconv.Itoa(this.LayoutDefault.Margin), strconv.Itoa(this.LayoutDefault.Spacing)
 	}
+	if this.LayoutFunction != nil {
+		if this.LayoutFunction.Margin != "" {
+			defaultMargin = this.LayoutFunction.Margin + "()"
+		}
+		if this.LayoutFunction.Spacing != "" {
+			defaultSpacing = this.LayoutFunction.Spacing + "()"
+		}
+	}
+	if nested {
+		defaultMargin = "0"
+	}
+	leftMargin, rightMargin, topMargin, bottomMargin := defaultMargin, defaultMargin, defaultMargin, defaultMargin
+	spacing := defaultSpacing
 
 	// Set Properties
 
-	for _, prop := range layout.Properties {
-		switch prop.Value.(type) {
-		case int:
-			value := prop.Value.(int)
-			switch prop.Name {
-			case "margin":
-				leftMargin = value
-				rightMargin = value
-				topMargin = value
-				bottomMargin = value
-			case "leftMargin":
-				leftMargin = value
-			case "rightMargin":
-				rightMargin = value
-			case "topMargin":
-				topMargin = value
-			case "bottomMargin":
-				bottomMargin = value
-			case "spacing":
-				spacing = value
+	// Properties may be named as on QLayoutWidget, e.g. layoutSpacing.
+	props := make([]*Property, len(layout.Properties))
+	for i, prop := range layout.Properties {
+		props[i] = prop
+		if name := strings.TrimPrefix(prop.Name, "layout"); name != prop.Name && name != "" {
+			renamed := *prop
+			renamed.Name = strings.ToLower(name[:1]) + name[1:]
+			props[i] = &renamed
+		}
+	}
+
+	for _, prop := range props {
+		v, ok := prop.Value.(int)
+		if !ok {
+			continue
+		}
+		value := strconv.Itoa(v)
+		switch prop.Name {
+		case "margin":
+			leftMargin = value
+			rightMargin = value
+			topMargin = value
+			bottomMargin = value
+		case "leftMargin":
+			leftMargin = value
+		case "rightMargin":
+			rightMargin = value
+		case "topMargin":
+			topMargin = value
+		case "bottomMargin":
+			bottomMargin = value
+		case "spacing":
+			spacing = value
+		}
+	}
+	if leftMargin != "" || topMargin != "" || rightMargin != "" || bottomMargin != "" {
+		// A negative margin is the style default.
+		margins := []string{leftMargin, topMargin, rightMargin, bottomMargin}
+		for i, margin := range margins {
+			if margin == "" {
+				margins[i] = "-1"
 			}
-		case *Enum:
 		}
+		this.addSetupUICode(fmt.Sprintf("this.%s.%s(%s)", layoutName,
+			this.overload(layout.Pos, layout.Class, "setContentsMargins", untypedInt, untypedInt, untypedInt, untypedInt), strings.Join(margins, ", ")))
+	}
+	if spacing != "" {
+		this.addSetupUICode(fmt.Sprintf("this.%s.SetSpacing(%s)", layoutName, spacing))
 	}
-	this.addSetupUICode(fmt.Sprintf("this.%s.SetContentsMargins(%d, %d, %d, %d)", layoutName, leftMargin, topMargin, rightMargin, bottomMargin))
-	this.addSetupUICode(fmt.Sprintf("this.%s.SetSpacing(%d)", layoutName, spacing))
-	for _, prop := range layout.Properties {
+	for _, prop := range props {
 		switch prop.Name {
 		case "margin":
 		case "leftMargin":
@@ -781,18 +1097,30 @@ func (this *compiler) translateLayout(parentName string, layout *QLayout) {
 		case "bottomMargin":
 		case "spacing":
 		default:
-			this.setProperty("this."+layoutName, prop)
+			// sizeConstraint and the other enums go through their setters.
+			this.setProperty(layout.Class, "this."+layoutName, prop)
 		}
 	}
 
 	// Set attributes
 	// TODO:
 
+	// Box layouts take the stretch of widgets and layouts in the add call.
+	var stretches []int
+	if layout.Class == "QVBoxLayout" || layout.Class == "QHBoxLayout" {
+		for _, part := range strings.Split(layout.Stretch, ",") {
+			stretch, _ := strconv.Atoi(strings.TrimSpace(part))
+			stretches = append(stretches, stretch)
+		}
+	}
+
 	// Translate items
-	if layout.Items != nil {
-		for _, item := range layout.Items {
-			this.translateLayoutItem(parentName, "this."+layoutName, layout.Class, item)
+	for i, item := range layout.Items {
+		stretch := 0
+		if i < len(stretches) {
+			stretch = stretches[i]
 		}
+		this.translateLayoutItem(parentName, "this."+layoutName, layout.Class, item, stretch)
 	}
 
 	// Set stretch & minimum size
@@ -802,13 +1130,19 @@ func (this *compiler) translateLayout(parentName string, layout *QLayout) {
 			for i, part := range parts {
 				stretch, _ := strconv.ParseInt(strings.TrimSpace(part), 10, 32)
 				if stretch > 0 {
-					this.addSetupUICode(fmt.Sprintf("this.%s.Set%s(%d, %d)", layoutName, propName, i, stretch))
+					this.addSetupUICode(fmt.Sprintf("this.%s.%s(%d, %d)", layoutName,
+						this.overload(layout.Pos, layout.Class, "set"+propName, untypedInt, untypedInt), i, stretch))
 				}
 			}
 		}
 	}
 
-	setCommaSplitProp("Stretch", layout.Stretch)
+	for i, item := range layout.Items {
+		if _, ok := item.View.(*QSpacer); ok && i < len(stretches) && stretches[i] > 0 {
+			this.addSetupUICode(fmt.Sprintf("this.%s.%s(%d, %d)", layoutName,
+				this.overload(layout.Pos, layout.Class, "setStretch", untypedInt, untypedInt), i, stretches[i]))
+		}
+	}
 	setCommaSplitProp("ColumnStretch", layout.ColumnStretch)
 	setCommaSplitProp("RowStretch", layout.RowStretch)
 	setCommaSplitProp("RowMinimumHeight", layout.RowMinimumHeight)
@@ -819,23 +1153,39 @@ func (this *compiler) translateAction(action *Action) {
 	varName := this.transVarName(action.Name)
 	this.addImport("widgets")
 	this.addVariableCode(fmt.Sprintf("%s *widgets.QAction", varName))
-	this.addSetupUICode(fmt.Sprintf("this.%s = widgets.NewQAction(%s)", varName, this.RootWidgetName))
+	this.addSetupUICode(fmt.Sprintf("this.%s = %s(%s)", varName, this.constructor(action.Pos, "QAction", "*core.QObject"), this.RootWidgetName))
 	this.addSetupUICode(fmt.Sprintf("this.%s.SetObjectName(\"%s\")", varName, action.Name))
 
 	for _, prop := range action.Props {
 		if prop.Name == "shortcut" {
 			value, _ := prop.Value.(*String)
 			this.addImport("gui")
-			this.addTranslateCode(fmt.Sprintf("%s.Set%s(gui.QKeySequence_FromString(_translate(\"%s\", %s, \"\", -1), gui.QKeySequence__NativeText))", "this."+varName,
-				this.toCamelCase(prop.Name), this.RootWidgetName, strconv.Quote(value.Value)))
+			this.addTranslateCode(fmt.Sprintf("%s.%s(gui.QKeySequence_FromString(%s, gui.QKeySequence__NativeText))", "this."+varName,
+				this.setter(prop.Pos, "QAction", prop.Name, "*gui.QKeySequence"), this.translateString(value)))
 		} else {
-			this.setProperty("this."+varName, prop)
+			this.setProperty("QAction", "this."+varName, prop)
 		}
 	}
 }
 
-func (this *compiler) translateActionGroup(actionGroup *ActionGroup) {
-	// TODO:
+// translateActionGroup creates actionGroup as a child of parentName, adds its
+// actions to it and recurses into its nested groups.
+func (this *compiler) translateActionGroup(parentName string, actionGroup *ActionGroup) {
+	varName := this.transVarName(actionGroup.Name)
+	this.addImport("widgets")
+	this.addVariableCode(fmt.Sprintf("%s *widgets.QActionGroup", varName))
+	this.addSetupUICode(fmt.Sprintf("this.%s = %s(%s)", varName, this.constructor(actionGroup.Pos, "QActionGroup", "*core.QObject"), parentName))
+	this.addSetupUICode(fmt.Sprintf("this.%s.SetObjectName(\"%s\")", varName, actionGroup.Name))
+	this.setProperties("QActionGroup", "this."+varName, actionGroup.Props)
+
+	for _, action := range actionGroup.Actions {
+		this.translateAction(action)
+		this.addSetupUICode(fmt.Sprintf("this.%s.AddAction(this.%s)", varName, this.transVarName(action.Name)))
+	}
+
+	for _, group := range actionGroup.ActionGroups {
+		this.translateActionGroup("this."+varName, group)
+	}
 }
 
 func (this *compiler) translateActionRef(parentName string, parentClass string, actionRef *ActionRef) {
@@ -850,7 +1200,7 @@ func (this *compiler) translateActionRef(parentName string, parentClass string,
 		case "QMenuBar":
 			this.addAddActionCode(fmt.Sprintf("this.%s.QWidget.AddAction(this.%s.MenuAction())", parentName, this.transVarName(actionRef.Name)))
 		default:
-			log.Errorf("%s action not supported")
+			this.errorf(actionRef.Pos, "%s action not supported", parentClass)
 		}
 	}
 }
@@ -868,26 +1218,29 @@ func (this *compiler) translateComboBox(widget *QWidget) {
 			for _, prop := range item.Props {
 				if prop.Name == "icon" {
 					icon := prop.Value.(*QIcon)
-					this.translateIcon(icon)
+					this.translateIcon(prop.Pos, icon)
 					hasIcon = true
 					break
 				}
 			}
+			variant := fmt.Sprintf("%s()", this.constructor(item.Pos, "QVariant"))
 			if hasIcon {
-				this.addSetupUICode(fmt.Sprintf("this.%s.AddItem2(icon, \"\", core.NewQVariant())", widgetName))
+				this.addSetupUICode(fmt.Sprintf("this.%s.%s(icon, \"\", %s)", widgetName,
+					this.overload(item.Pos, widget.Class, "addItem", "*gui.QIcon", "string", "*core.QVariant"), variant))
 			} else {
-				this.addSetupUICode(fmt.Sprintf("this.%s.AddItem(\"\", core.NewQVariant())", widgetName))
+				this.addSetupUICode(fmt.Sprintf("this.%s.%s(\"\", %s)", widgetName,
+					this.overload(item.Pos, widget.Class, "addItem", "string", "*core.QVariant"), variant))
 			}
 
 			for _, prop := range item.Props {
 				if prop.Name != "text" {
 					if prop.Name != "icon" {
-						log.Errorf("unknown combobox item property %s", prop.Name)
+						this.errorf(prop.Pos, "unknown combobox item property %s", prop.Name)
 					}
 					continue
 				}
 				value, _ := prop.Value.(*String)
-				this.addTranslateCode(fmt.Sprintf("this.%s.SetItemText(%d, _translate(\"%s\", %s, \"\", -1))", widgetName, i, this.RootWidgetName, strconv.Quote(value.Value)))
+				this.addTranslateCode(fmt.Sprintf("this.%s.SetItemText(%d, %s)", widgetName, i, this.translateString(value)))
 			}
 		}
 	}
@@ -906,16 +1259,17 @@ func (this *compiler) translateListWidget(widget *QWidget) {
 		this.defineSortingEnabled()
 		this.addTranslateCode(fmt.Sprintf("sortingEnabled = this.%s.IsSortingEnabled()", widgetName))
 		for i, item := range widget.Items {
-			this.addSetupUICode("listItem = widgets.NewQListWidgetItem(nil, 0)")
-			this.addSetupUICode(fmt.Sprintf("this.%s.AddItem2(listItem)", widgetName))
+			this.addSetupUICode(fmt.Sprintf("listItem = %s(nil, 0)", this.constructor(item.Pos, "QListWidgetItem", untypedNil, untypedInt)))
+			this.addSetupUICode(fmt.Sprintf("this.%s.%s(listItem)", widgetName,
+				this.overload(item.Pos, widget.Class, "addItem", "*widgets.QListWidgetItem")))
 			for _, prop := range item.Props {
 				if prop.Name != "text" {
-					log.Errorf("unknown list widget item property %s", prop.Name)
+					this.errorf(prop.Pos, "unknown list widget item property %s", prop.Name)
 					continue
 				}
 				value, _ := prop.Value.(*String)
 
-				this.addTranslateCode(fmt.Sprintf("this.%s.Item(%d).SetText(_translate(\"%s\", %s, \"\", -1))", widgetName, i, this.RootWidgetName, strconv.Quote(value.Value)))
+				this.addTranslateCode(fmt.Sprintf("this.%s.Item(%d).SetText(%s)", widgetName, i, this.translateString(value)))
 			}
 		}
 		this.addTranslateCode(fmt.Sprintf("this.%s.SetSortingEnabled(sortingEnabled)", widgetName))
@@ -931,16 +1285,16 @@ func (this *compiler) translateTableWidget(widget *QWidget) {
 	if len(widget.Rows) > 0 {
 		this.defineTableItem()
 		for i, row := range widget.Rows {
-			this.addSetupUICode("tableItem = widgets.NewQTableWidgetItem(0)")
+			this.addSetupUICode(fmt.Sprintf("tableItem = %s(0)", this.constructor(widget.Pos, "QTableWidgetItem", untypedInt)))
 			this.addSetupUICode(fmt.Sprintf("this.%s.SetVerticalHeaderItem(%d, tableItem)", widgetName, i))
 			for _, prop := range row.Props {
 				if prop.Name != "text" {
-					log.Errorf("unknown table widget header item property %s", prop.Name)
+					this.errorf(prop.Pos, "unknown table widget header item property %s", prop.Name)
 					continue
 				}
 				value, _ := prop.Value.(*String)
 
-				this.addTranslateCode(fmt.Sprintf("this.%s.VerticalHeaderItem(%d).SetText(_translate(\"%s\", %s, \"\", -1))", widgetName, i, this.RootWidgetName, strconv.Quote(value.Value)))
+				this.addTranslateCode(fmt.Sprintf("this.%s.VerticalHeaderItem(%d).SetText(%s)", widgetName, i, this.translateString(value)))
 			}
 		}
 	}
@@ -948,16 +1302,16 @@ func (this *compiler) translateTableWidget(widget *QWidget) {
 	if len(widget.Columns) > 0 {
 		this.defineTableItem()
 		for i, column := range widget.Columns {
-			this.addSetupUICode("tableItem = widgets.NewQTableWidgetItem(0)")
+			this.addSetupUICode(fmt.Sprintf("tableItem = %s(0)", this.constructor(widget.Pos, "QTableWidgetItem", untypedInt)))
 			this.addSetupUICode(fmt.Sprintf("this.%s.SetHorizontalHeaderItem(%d, tableItem)", widgetName, i))
 			for _, prop := range column.Props {
 				if prop.Name != "text" {
-					log.Errorf("unknown table widget header item property %s", prop.Name)
+					this.errorf(prop.Pos, "unknown table widget header item property %s", prop.Name)
 					continue
 				}
 				value, _ := prop.Value.(*String)
 
-				this.addTranslateCode(fmt.Sprintf("this.%s.HorizontalHeaderItem(%d).SetText(_translate(\"%s\", %s, \"\", -1))", widgetName, i, this.RootWidgetName, strconv.Quote(value.Value)))
+				this.addTranslateCode(fmt.Sprintf("this.%s.HorizontalHeaderItem(%d).SetText(%s)", widgetName, i, this.translateString(value)))
 			}
 		}
 	}
@@ -968,16 +1322,16 @@ func (this *compiler) translateTableWidget(widget *QWidget) {
 		this.addTranslateCode(fmt.Sprintf("sortingEnabled = this.%s.IsSortingEnabled()", widgetName))
 
 		for _, item := range widget.Items {
-			this.addSetupUICode("tableItem = widgets.NewQTableWidgetItem(0)")
+			this.addSetupUICode(fmt.Sprintf("tableItem = %s(0)", this.constructor(widget.Pos, "QTableWidgetItem", untypedInt)))
 			this.addSetupUICode(fmt.Sprintf("this.%s.SetItem(%d, %d, tableItem)", widgetName, item.Row, item.Column))
 			for _, prop := range item.Props {
 				if prop.Name != "text" {
-					log.Errorf("unknown table widget header item property %s", prop.Name)
+					this.errorf(prop.Pos, "unknown table widget header item property %s", prop.Name)
 					continue
 				}
 				value, _ := prop.Value.(*String)
 
-				this.addTranslateCode(fmt.Sprintf("this.%s.Item(%d, %d).SetText(_translate(\"%s\", %s, \"\", -1))", widgetName, item.Row, item.Column, this.RootWidgetName, strconv.Quote(value.Value)))
+				this.addTranslateCode(fmt.Sprintf("this.%s.Item(%d, %d).SetText(%s)", widgetName, item.Row, item.Column, this.translateString(value)))
 			}
 		}
 		this.addTranslateCode(fmt.Sprintf("this.%s.SetSortingEnabled(sortingEnabled)", widgetName))
@@ -1005,15 +1359,17 @@ func (this *compiler) translateTableWidget(widget *QWidget) {
 		}
 		switch attr.Value.(type) {
 		case string:
-			log.Errorf("string attribute not support by table widget ")
+			this.errorf(attr.Pos, "string attribute not supported by table widget")
 		case int:
 			value := attr.Value.(int)
-			this.addSetupUICode(fmt.Sprintf("this.%s.%s().Set%s(%d)", widgetName, funcName, propName, value))
+			this.addSetupUICode(fmt.Sprintf("this.%s.%s().%s(%d)", widgetName, funcName,
+				this.setter(attr.Pos, "QHeaderView", propName, untypedInt), value))
 		case float64:
-			log.Errorf("double attribute not support by table widget ")
+			this.errorf(attr.Pos, "double attribute not supported by table widget")
 		case bool:
 			value := attr.Value.(bool)
-			this.addSetupUICode(fmt.Sprintf("this.%s.%s().Set%s(%s)", widgetName, funcName, propName, boolToString(value)))
+			this.addSetupUICode(fmt.Sprintf("this.%s.%s().%s(%s)", widgetName, funcName,
+				this.setter(attr.Pos, "QHeaderView", propName, "bool"), boolToString(value)))
 		}
 	}
 }
@@ -1026,12 +1382,12 @@ func (this *compiler) translateTreeItemProps(callObject string, varName string,
 			column++
 			value := prop.Value.(*String)
 			if value.Value != "" {
-				this.addTranslateCode(fmt.Sprintf("%s.SetText(%d, _translate(\"%s\", %s, \"\", -1))", callObject, column, this.RootWidgetName, strconv.Quote(value.Value)))
+				this.addTranslateCode(fmt.Sprintf("%s.SetText(%d, %s)", callObject, column, this.translateString(value)))
 			}
 			continue
 		}
 
-		this.setPropertyEx(varName, fmt.Sprintf("%d, ", column), prop)
+		this.setPropertyEx("QTreeWidgetItem", varName, fmt.Sprintf("%d, ", column), prop)
 	}
 }
 
@@ -1056,18 +1412,24 @@ func (this *compiler) translateTreeWidgetItem(callObject string, parentName stri
 		if this.needDefineTreeItemVar(childItem) {
 			varName := this.defineTreeItem()
 
-			this.addSetupUICode(fmt.Sprintf("%s = widgets.NewQTreeWidgetItem6(%s, 0)", varName, parentName))
+			this.addSetupUICode(fmt.Sprintf("%s = %s(%s, 0)", varName, this.treeItemConstructor(childItem.Pos, "*widgets.QTreeWidgetItem"), parentName))
 			childCallObject := fmt.Sprintf("%s.Child(%d)", callObject, i)
 			this.translateTreeItemProps(childCallObject, varName, childItem)
 			this.translateTreeWidgetItem(childCallObject, varName, childItem)
 
 			this.undefineTreeItem(varName)
 		} else {
-			this.addSetupUICode(fmt.Sprintf("widgets.NewQTreeWidgetItem6(%s, 0)", parentName))
+			this.addSetupUICode(fmt.Sprintf("%s(%s, 0)", this.treeItemConstructor(childItem.Pos, "*widgets.QTreeWidgetItem"), parentName))
 		}
 	}
 }
 
+// treeItemConstructor returns the constructor of tree widget items with a
+// parent of type parentType.
+func (this *compiler) treeItemConstructor(pos Position, parentType string) string {
+	return this.constructor(pos, "QTreeWidgetItem", parentType, untypedInt)
+}
+
 func (this *compiler) translateTreeWidget(widget *QWidget) {
 	widgetName := this.transVarName(widget.Name)
 
@@ -1076,17 +1438,17 @@ func (this *compiler) translateTreeWidget(widget *QWidget) {
 
 	if widget.Columns != nil && len(widget.Columns) > 0 {
 		varName := this.defineTreeItem()
-		this.addSetupUICode(fmt.Sprintf("%s = widgets.NewQTreeWidgetItem3(this.%s, 0)", varName, widgetName))
+		this.addSetupUICode(fmt.Sprintf("%s = %s(this.%s, 0)", varName, this.treeItemConstructor(widget.Pos, "*widgets.QTreeWidget"), widgetName))
 		this.addSetupUICode(fmt.Sprintf("this.%s.SetHeaderItem(%s)", widgetName, varName))
 		for i, column := range widget.Columns {
 			for _, prop := range column.Props {
 				if prop.Name != "text" {
-					log.Errorf("unknown tree widget header item property %s", prop.Name)
+					this.errorf(prop.Pos, "unknown tree widget header item property %s", prop.Name)
 					continue
 				}
 				value, _ := prop.Value.(*String)
 
-				this.addTranslateCode(fmt.Sprintf("this.%s.HeaderItem().SetText(%d, _translate(\"%s\", %s, \"\", -1))", widgetName, i, this.RootWidgetName, strconv.Quote(value.Value)))
+				this.addTranslateCode(fmt.Sprintf("this.%s.HeaderItem().SetText(%d, %s)", widgetName, i, this.translateString(value)))
 			}
 		}
 		this.undefineTreeItem(varName)
@@ -1097,14 +1459,14 @@ func (this *compiler) translateTreeWidget(widget *QWidget) {
 		for i, item := range widget.Items {
 			if this.needDefineTreeItemVar(item) {
 				varName := this.defineTreeItem()
-				this.addSetupUICode(fmt.Sprintf("%s = widgets.NewQTreeWidgetItem3(this.%s, 0)", varName, widgetName))
+				this.addSetupUICode(fmt.Sprintf("%s = %s(this.%s, 0)", varName, this.treeItemConstructor(item.Pos, "*widgets.QTreeWidget"), widgetName))
 				callObject := fmt.Sprintf("this.%s.TopLevelItem(%d)", widgetName, i)
 				this.translateTreeItemProps(callObject, varName, item)
 				this.translateTreeWidgetItem(callObject, varName, item)
 
 				this.undefineTreeItem(varName)
 			} else {
-				this.addSetupUICode(fmt.Sprintf("widgets.NewQTreeWidgetItem3(this.%s, 0)", widgetName))
+				this.addSetupUICode(fmt.Sprintf("%s(this.%s, 0)", this.treeItemConstructor(item.Pos, "*widgets.QTreeWidget"), widgetName))
 			}
 		}
 	}
@@ -1120,15 +1482,17 @@ func (this *compiler) translateTreeWidget(widget *QWidget) {
 		}
 		switch attr.Value.(type) {
 		case string:
-			log.Errorf("string attribute not support by tree widget ")
+			this.errorf(attr.Pos, "string attribute not supported by tree widget")
 		case int:
 			value := attr.Value.(int)
-			this.addSetupUICode(fmt.Sprintf("this.%s.Header().Set%s(%d)", widgetName, propName, value))
+			this.addSetupUICode(fmt.Sprintf("this.%s.Header().%s(%d)", widgetName,
+				this.setter(attr.Pos, "QHeaderView", propName, untypedInt), value))
 		case float64:
-			log.Errorf("double attribute not support by table widget ")
+			this.errorf(attr.Pos, "double attribute not supported by tree widget")
 		case bool:
 			value := attr.Value.(bool)
-			this.addSetupUICode(fmt.Sprintf("this.%s.Header().Set%s(%s)", widgetName, propName, boolToString(value)))
+			this.addSetupUICode(fmt.Sprintf("this.%s.Header().%s(%s)", widgetName,
+				this.setter(attr.Pos, "QHeaderView", propName, "bool"), boolToString(value)))
 		}
 	}
 }
@@ -1141,38 +1505,48 @@ func (this *compiler) convertLineWidget(widget *QWidget) *QWidget {
 	props := []*Property{}
 	for _, prop := range widget.Properties {
 		if prop.Name == "orientation" {
-			props = append(props, &Property{Name: "frameShadow", Value: &Enum{Value: "QFrame::Sunken"}})
+			props = append(props, &Property{Pos: prop.Pos, Name: "frameShadow", StdSet: true, Value: &Enum{Value: "QFrame::Sunken"}})
 			orientation := prop.Value.(*Enum)
 			if orientation.Value == "Qt::Horizontal" {
-				props = append(props, &Property{Name: "frameShape", Value: &Enum{Value: "QFrame::HLine"}})
+				props = append(props, &Property{Pos: prop.Pos, Name: "frameShape", StdSet: true, Value: &Enum{Value: "QFrame::HLine"}})
 			} else {
-				props = append(props, &Property{Name: "frameShape", Value: &Enum{Value: "QFrame::VLine"}})
+				props = append(props, &Property{Pos: prop.Pos, Name: "frameShape", StdSet: true, Value: &Enum{Value: "QFrame::VLine"}})
 			}
 		} else {
 			props = append(props, prop)
 		}
 	}
-	return &QWidget{Name: widget.Name, Class: "QFrame", Properties: props}
+	return &QWidget{Pos: widget.Pos, Name: widget.Name, Class: "QFrame", Properties: props}
 }
 
 func (this *compiler) translateWidget(parentName string, widget *QWidget) {
 	widget = this.convertLineWidget(widget)
 
 	widgetName := this.transVarName(widget.Name)
+	class := this.baseClass(widget.Class)
+	customWidget := this.customWidget(widget.Class)
 	this.addImport("widgets")
-	this.addVariableCode(fmt.Sprintf("%s *widgets.%s", widgetName, widget.Class))
-	switch widget.Class {
-	case "QWidget":
-		fallthrough
-	case "QFrame":
-		fallthrough
-	case "QLabel":
-		this.addImport("core")
-		this.addSetupUICode(fmt.Sprintf("this.%s = widgets.New%s(%s, core.Qt__Widget)", widgetName, widget.Class, parentName))
-	case "QToolBar":
-		this.addSetupUICode(fmt.Sprintf("this.%s = widgets.New%s2(%s)", widgetName, widget.Class, parentName))
-	default:
-		this.addSetupUICode(fmt.Sprintf("this.%s = widgets.New%s(%s)", widgetName, widget.Class, parentName))
+	if customWidget != nil {
+		goType, constructor := this.customWidgetType(customWidget)
+		this.addVariableCode(fmt.Sprintf("%s *%s", widgetName, goType))
+		this.addSetupUICode(fmt.Sprintf("this.%s = %s(%s)", widgetName, constructor, parentName))
+	} else {
+		this.addVariableCode(fmt.Sprintf("%s *widgets.%s", widgetName, widget.Class))
+		switch widget.Class {
+		case "QWidget":
+			fallthrough
+		case "QFrame":
+			fallthrough
+		case "QLabel":
+			fallthrough
+		case "QToolBox":
+			this.addImport("core")
+			this.addSetupUICode(fmt.Sprintf("this.%s = %s(%s, core.Qt__Widget)", widgetName,
+				this.constructor(widget.Pos, widget.Class, "*widgets.QWidget", anyEnum), parentName))
+		default:
+			this.addSetupUICode(fmt.Sprintf("this.%s = %s(%s)", widgetName,
+				this.constructor(widget.Pos, widget.Class, "*widgets.QWidget"), parentName))
+		}
 	}
 	this.addSetupUICode(fmt.Sprintf("this.%s.SetObjectName(\"%s\")", widgetName, widgetName))
 
@@ -1182,11 +1556,11 @@ func (this *compiler) translateWidget(parentName string, widget *QWidget) {
 			currentIndex, _ := prop.Value.(int)
 			this.addSetCurrentIndexCode(fmt.Sprintf("this.%s.SetCurrentIndex(%d)", widgetName, currentIndex))
 		} else {
-			this.setProperty("this."+widgetName, prop)
+			this.setProperty(widget.Class, "this."+widgetName, prop)
 		}
 	}
 
-	switch widget.Class {
+	switch class {
 	case "QComboBox":
 		this.translateComboBox(widget)
 	case "QListWidget":
@@ -1210,28 +1584,22 @@ func (this *compiler) translateWidget(parentName string, widget *QWidget) {
 	}
 
 	if widget.Layout != nil {
-		this.translateLayout("this."+widgetName, widget.Layout)
+		this.translateLayout("this."+widgetName, widget.Layout, false)
 	}
 
 	if widget.Widgets != nil {
 		for _, childWidget := range widget.Widgets {
 			this.translateWidget("this."+widgetName, childWidget)
 			childWidgetName := this.transVarName(childWidget.Name)
-			switch widget.Class {
+			if customWidget != nil && customWidget.AddPageMethod != "" {
+				this.addSetupUICode(fmt.Sprintf("this.%s.%s(this.%s)", widgetName, ToCamelCase(customWidget.AddPageMethod), childWidgetName))
+				continue
+			}
+			switch class {
 			case "QTabWidget":
-				this.addSetupUICode(fmt.Sprintf("this.%s.AddTab(this.%s, \"\")", widgetName, childWidgetName))
-
-				for _, attr := range childWidget.Attributes {
-					if attr.Name == "title" {
-						value := attr.Value.(*String)
-						this.addTranslateCode(fmt.Sprintf("this.%s.SetTabText(this.%s.IndexOf(this.%s), _translate(\"%s\", %s, \"\", -1))",
-							widgetName,
-							widgetName,
-							childWidgetName,
-							this.RootWidgetName,
-							strconv.Quote(value.Value)))
-					}
-				}
+				this.translateTabPage(widget, childWidget)
+			case "QToolBox":
+				this.translateToolBoxPage(widget, childWidget)
 			case "QStackedWidget":
 				this.addSetupUICode(fmt.Sprintf("this.%s.AddWidget(this.%s)", widgetName, childWidgetName))
 			case "QWidget":
@@ -1241,7 +1609,7 @@ func (this *compiler) translateWidget(parentName string, widget *QWidget) {
 			case "QScrollArea":
 				this.addSetupUICode(fmt.Sprintf("this.%s.SetWidget(this.%s)", widgetName, childWidgetName))
 			default:
-				log.Warnf("Should add code for %s inner widget?", widgetName)
+				this.warnf(childWidget.Pos, "should add code for %s inner widget?", widgetName)
 			}
 		}
 	}
@@ -1254,13 +1622,13 @@ func (this *compiler) translateWidget(parentName string, widget *QWidget) {
 
 	if widget.ActionsGroups != nil {
 		for _, actionGroup := range widget.ActionsGroups {
-			this.translateActionGroup(actionGroup)
+			this.translateActionGroup(this.RootWidgetName, actionGroup)
 		}
 	}
 
 	if widget.AddActions != nil {
 		for _, actionRef := range widget.AddActions {
-			this.translateActionRef(widgetName, widget.Class, actionRef)
+			this.translateActionRef(widgetName, class, actionRef)
 		}
 	}
 
@@ -1271,45 +1639,133 @@ func (this *compiler) translateWidget(parentName string, widget *QWidget) {
 	}
 }
 
+// translateTabPage adds the page to the tab widget. The title, tool tip and
+// what's this are set in RetranslateUi.
+func (this *compiler) translateTabPage(tabWidget *QWidget, page *QWidget) {
+	this.translatePage(tabWidget, page, "addTab", map[string]string{
+		"title":     "SetTabText",
+		"toolTip":   "SetTabToolTip",
+		"whatsThis": "SetTabWhatsThis",
+	})
+}
+
+// translateToolBoxPage adds the page to the tool box. Like tab titles, the
+// label and tool tip are set in RetranslateUi.
+func (this *compiler) translateToolBoxPage(toolBox *QWidget, page *QWidget) {
+	this.translatePage(toolBox, page, "addItem", map[string]string{
+		"label":   "SetItemText",
+		"toolTip": "SetItemToolTip",
+	})
+}
+
+// translatePage adds the page to the container with the method add, which
+// takes the icon attribute of the page if there is one. The string
+// attributes of the page are set with the index setters by attribute name,
+// other attributes are warned about.
+func (this *compiler) translatePage(container *QWidget, page *QWidget, add string, setters map[string]string) {
+	containerName := this.transVarName(container.Name)
+	pageName := this.transVarName(page.Name)
+
+	var icon *QIcon
+	for _, attr := range page.Attributes {
+		if attr.Name == "icon" {
+			icon, _ = attr.Value.(*QIcon)
+		}
+	}
+	if icon != nil {
+		this.translateIcon(page.Pos, icon)
+		this.addSetupUICode(fmt.Sprintf("this.%s.%s(this.%s, icon, \"\")", containerName,
+			this.overload(page.Pos, container.Class, add, "*widgets.QWidget", "*gui.QIcon", "string"), pageName))
+	} else {
+		this.addSetupUICode(fmt.Sprintf("this.%s.%s(this.%s, \"\")", containerName,
+			this.overload(page.Pos, container.Class, add, "*widgets.QWidget", "string"), pageName))
+	}
+
+	for _, attr := range page.Attributes {
+		if attr.Name == "icon" {
+			continue
+		}
+		setter, ok := setters[attr.Name]
+		if !ok {
+			this.warnf(attr.Pos, "unsupported attribute %s of %s page %s", attr.Name, container.Class, page.Name)
+			continue
+		}
+		value, ok := attr.Value.(*String)
+		if !ok {
+			this.warnf(attr.Pos, "attribute %s of %s page %s is not a string", attr.Name, container.Class, page.Name)
+			continue
+		}
+		if value.NotR {
+			this.addSetupUICode(fmt.Sprintf("this.%s.%s(this.%s.IndexOf(this.%s), %s)", containerName, setter, containerName, pageName,
+				strconv.Quote(value.Value)))
+		} else {
+			this.addTranslateCode(fmt.Sprintf("this.%s.%s(this.%s.IndexOf(this.%s), %s)", containerName, setter, containerName, pageName,
+				this.translateString(value)))
+		}
+	}
+}
+
 func (this *compiler) getTabStopCodes(indent string) string {
-	if len(this.tabStops) == 0 {
+	if len(this.TabStops) == 0 {
 		return ""
 	}
 
-	lines := make([]string, len(this.tabStops)-1)
+	lines := make([]string, len(this.TabStops)-1)
 
-	for i, n := range this.tabStops {
-		if i == len(this.tabStops)-1 {
+	for i, n := range this.TabStops {
+		if i == len(this.TabStops)-1 {
 			break
 		}
 
-		next := this.tabStops[i+1]
+		next := this.TabStops[i+1]
 		lines[i] = fmt.Sprintf("%s%s.SetTabOrder(this.%s, this.%s)", indent, this.RootWidgetName, this.transVarName(n), this.transVarName(next))
 	}
 	return "\n" + strings.Join(lines, "\n")
 }
 
+// parseSignature splits a signal or slot signature like
+// "dataChanged(QModelIndex, QModelIndex, QVector<int>)" into its name and
+// trimmed parameter types.
 func (this *compiler) parseSignature(signature string) (name string, params []string) {
 	i := strings.Index(signature, "(")
-	j := strings.Index(signature, ")")
+	j := strings.LastIndex(signature, ")")
+	if i < 0 || j < i {
+		return strings.TrimSpace(signature), nil
+	}
 
 	name = strings.TrimSpace(signature[:i])
 	argString := strings.TrimSpace(signature[i+1 : j])
-	if argString != "" {
-		params = strings.Split(argString, ",")
+	if argString == "" {
+		return
+	}
+
+	depth, start := 0, 0
+	for k, c := range argString {
+		switch c {
+		case '<':
+			depth++
+		case '>':
+			depth--
+		case ',':
+			if depth == 0 {
+				params = append(params, strings.TrimSpace(argString[start:k]))
+				start = k + 1
+			}
+		}
 	}
+	params = append(params, strings.TrimSpace(argString[start:]))
 	return
 }
 
 func (this *compiler) getConnectionCodes(indent string) string {
-	if len(this.connections) == 0 {
+	if len(this.Connections) == 0 {
 		return ""
 	}
 
 	var lines []string
 
 outer:
-	for _, n := range this.connections {
+	for _, n := range this.Connections {
 		var sender, receiver string
 
 		sender = this.transVarName(n.Sender)
@@ -1325,26 +1781,42 @@ outer:
 			receiver = "this"
 		}
 
-		signal, signalParams := this.parseSignature(n.Signal)
-		slot, slotParams := this.parseSignature(n.Slot)
+		signal, cppSignalParams := this.parseSignature(n.Signal)
+		slot, cppSlotParams := this.parseSignature(n.Slot)
+
+		signalParams, err := this.goTypes(cppSignalParams)
+		if err != nil {
+			this.errorf(n.Pos, "signal %s.%s: %v", n.Sender, n.Signal, err)
+			continue
+		}
+		slotParams, err := this.goTypes(cppSlotParams)
+		if err != nil {
+			this.errorf(n.Pos, "slot %s.%s: %v", n.Receiver, n.Slot, err)
+			continue
+		}
+
+		connector, connectorParams, err := this.signalConnector(this.objectClass(n.Sender), signal, signalParams)
+		if err != nil {
+			this.errorf(n.Pos, "signal %s.%s: %v", n.Sender, n.Signal, err)
+			continue
+		}
 
 		// Check params
 
 		if len(slotParams) > len(signalParams) {
-			log.Errorf("%s.%s and %s.%s argument mismatched!!!", n.Sender, n.Signal, n.Receiver, n.Slot)
+			this.errorf(n.Pos, "%s.%s and %s.%s argument mismatched", n.Sender, n.Signal, n.Receiver, n.Slot)
 			continue
 		}
 		for i, paramType := range slotParams {
 			signalParamType := signalParams[i]
-			println(signalParamType, paramType)
 			if paramType != signalParamType {
-				log.Errorf("%s.%s and %s.%s argument type mismatched!!!", n.Sender, n.Signal, n.Receiver, n.Slot)
+				this.errorf(n.Pos, "%s.%s and %s.%s argument type mismatched", n.Sender, n.Signal, n.Receiver, n.Slot)
 				continue outer
 			}
 		}
 
-		if len(signalParams) == len(slotParams) {
-			lines = append(lines, fmt.Sprintf("%s%s.Connect%s(%s.%s)", indent, sender, ToCamelCase(signal), receiver, ToCamelCase(slot)))
+		if len(connectorParams) == len(slotParams) {
+			lines = append(lines, fmt.Sprintf("%s%s.%s(%s.%s)", indent, sender, connector, receiver, ToCamelCase(slot)))
 		} else {
 			// Wrap slot to fit signal prototype
 			wrapperCodes := []string{}
@@ -1352,8 +1824,8 @@ outer:
 				wrapperCodes = append(wrapperCodes, line)
 			}
 
-			signalArgs := make([]string, len(signalParams))
-			for i, paramType := range signalParams {
+			signalArgs := make([]string, len(connectorParams))
+			for i, paramType := range connectorParams {
 				signalArgs[i] = fmt.Sprintf("arg%d %s", i, paramType)
 			}
 
@@ -1365,28 +1837,42 @@ outer:
 			addCode(fmt.Sprintf("func (%s) {", strings.Join(signalArgs, ", ")))
 			addCode(fmt.Sprintf("%s%s%s.%s(%s)", indent, indent, receiver, ToCamelCase(slot), strings.Join(slotArgs, ", ")))
 			addCode(fmt.Sprintf("%s}", indent))
-			lines = append(lines, fmt.Sprintf("%s%s.Connect%s(%s)", indent, sender, ToCamelCase(signal), strings.Join(wrapperCodes, "\n")))
+			lines = append(lines, fmt.Sprintf("%s%s.%s(%s)", indent, sender, connector, strings.Join(wrapperCodes, "\n")))
 		}
 	}
 	return "\n" + strings.Join(lines, "\n")
 }
 
+// GenerateCode writes the Go code of the form to goFile. Nothing is written
+// if errors were reported to Diagnostics.
 func (this *compiler) GenerateCode(packageName string, goFile string) error {
+	code := this.generateCode(packageName)
+	if this.errorCount > 0 {
+		return fmt.Errorf("%s: %d error(s), no code generated", this.File, this.errorCount)
+	}
+	return writeSource(goFile, code)
+}
+
+// generateCode returns the Go code of the form in package packageName,
+// including the code of constructs errors were reported for.
+func (this *compiler) generateCode(packageName string) string {
 	className := this.getClassName()
-	widgetName := this.transVarName(this.widget.Name)
+	widgetName := this.transVarName(this.Widget.Name)
 	this.RootWidgetName = widgetName
 
+	this.loadResources()
+
 	this.addSetupUICode(fmt.Sprintf("%s.SetObjectName(\"%s\")", widgetName, widgetName))
-	this.setProperties(widgetName, this.widget.Properties)
+	this.setProperties(this.Widget.Class, widgetName, this.Widget.Properties)
 
-	if this.widget.Layout != nil {
-		this.translateLayout(widgetName, this.widget.Layout)
+	if this.Widget.Layout != nil {
+		this.translateLayout(widgetName, this.Widget.Layout, false)
 	}
 
-	if this.widget.Widgets != nil {
-		for _, widget := range this.widget.Widgets {
+	if this.Widget.Widgets != nil {
+		for _, widget := range this.Widget.Widgets {
 			this.translateWidget(widgetName, widget)
-			switch widget.Class {
+			switch this.baseClass(widget.Class) {
 			case "QMenuBar":
 				this.addSetupUICode(fmt.Sprintf("%s.SetMenuBar(this.%s)", widgetName, this.transVarName(widget.Name)))
 			case "QStatusBar":
@@ -1401,40 +1887,59 @@ func (this *compiler) GenerateCode(packageName string, goFile string) error {
 					}
 				}
 			case "QWidget":
-				if this.widget.Class == "QMainWindow" {
+				if this.Widget.Class == "QMainWindow" {
 					this.addSetupUICode(fmt.Sprintf("%s.SetCentralWidget(this.%s)", widgetName, this.transVarName(widget.Name)))
 				}
 			}
 		}
 	}
 
-	if this.widget.Actions != nil {
-		for _, action := range this.widget.Actions {
+	if this.Widget.Actions != nil {
+		for _, action := range this.Widget.Actions {
 			this.translateAction(action)
 		}
 	}
 
-	if this.widget.ActionsGroups != nil {
-		for _, actionGroup := range this.widget.ActionsGroups {
-			this.translateActionGroup(actionGroup)
+	if this.Widget.ActionsGroups != nil {
+		for _, actionGroup := range this.Widget.ActionsGroups {
+			this.translateActionGroup(this.RootWidgetName, actionGroup)
 		}
 	}
 
-	if this.widget.AddActions != nil {
-		for _, actionRef := range this.widget.AddActions {
-			this.translateActionRef(widgetName, this.widget.Class, actionRef)
+	if this.Widget.AddActions != nil {
+		for _, actionRef := range this.Widget.AddActions {
+			this.translateActionRef(widgetName, this.Widget.Class, actionRef)
 		}
 	}
 
-	if this.widget.ZOrders != nil {
-		for _, zorder := range this.widget.ZOrders {
+	if this.Widget.ZOrders != nil {
+		for _, zorder := range this.Widget.ZOrders {
 			this.translateZOrder(zorder)
 		}
 	}
 
 	this.SetupUICodes = append(this.SetupUICodes, this.AddActionCodes...)
 
+	setupUIParams := fmt.Sprintf("%s *widgets.%s", widgetName, this.Widget.Class)
+	setupUIResult := ""
+	slotsByNameCode := ""
+	if this.ConnectSlotsByName {
+		this.addImport("github.com/stephenlyu/goqtuic/autoconnect")
+		setupUIParams += ", handler interface{}"
+		setupUIResult = " error"
+		slotsByNameCode = fmt.Sprintf("\n    return autoconnect.ConnectSlotsByName(this, handler, map[string]interface{}{%q: %s})", widgetName, widgetName)
+	}
+
 	indent := "	"
+	// Connections may need imports for their parameter types.
+	connectionCodes := this.getConnectionCodes(indent)
+	// _translate is declared only if used, forms without text do not compile
+	// otherwise.
+	translateCodes := this.getTranslateCodes(indent)
+	if strings.Contains(translateCodes, "_translate(") {
+		this.addImport("core")
+		translateCodes = indent + "_translate := core.QCoreApplication_Translate\n" + translateCodes
+	}
 	code := fmt.Sprintf(`// WARNING! All changes made in this file will be lost!
 package %s
 
@@ -1446,15 +1951,14 @@ type UI%s struct {
 %s
 }
 
-func (this *UI%s) SetupUI(%s *widgets.%s) {
+func (this *UI%s) SetupUI(%s)%s {
 %s%s
 
     this.RetranslateUi(%s)
-%s%s%s
+%s%s%s%s
 }
 
 func (this *UI%s) RetranslateUi(%s *widgets.%s) {
-    _translate := core.QCoreApplication_Translate
 %s
 }
 `, packageName,
@@ -1462,31 +1966,30 @@ func (this *UI%s) RetranslateUi(%s *widgets.%s) {
 		className,
 		this.getVariableCodes(indent),
 		className,
-		widgetName,
-		this.widget.Class,
+		setupUIParams,
+		setupUIResult,
 		this.getSetupUICodes(indent),
 		this.getBuddyCodes(indent),
 		widgetName,
 		this.getSetCurrentIndexCodes(indent),
 		this.getTabStopCodes(indent),
-		this.getConnectionCodes(indent),
+		connectionCodes,
+		slotsByNameCode,
 		className,
 		widgetName,
-		this.widget.Class,
-		this.getTranslateCodes(indent))
+		this.Widget.Class,
+		translateCodes)
 
-	dir := filepath.Dir(goFile)
-	os.MkdirAll(dir, 0755)
-	return ioutil.WriteFile(goFile, []byte(code), 0644)
+	return code
 }
 
 func (this *compiler) needSubclassing() bool {
-	if len(this.connections) == 0 {
+	if len(this.Connections) == 0 {
 		return false
 	}
 
-	for _, conn := range this.connections {
-		if conn.Receiver == this.widget.Name {
+	for _, conn := range this.Connections {
+		if conn.Receiver == this.Widget.Name {
 			return true
 		}
 	}
@@ -1500,12 +2003,17 @@ func (this *compiler) generateSlotOverrideFunctionCode() string {
 		codes = append(codes, code)
 	}
 
-	for _, conn := range this.connections {
-		if conn.Receiver != this.widget.Name {
+	for _, conn := range this.Connections {
+		if conn.Receiver != this.Widget.Name {
 			continue
 		}
 
-		slot, slotParams := this.parseSignature(conn.Slot)
+		slot, cppSlotParams := this.parseSignature(conn.Slot)
+		slotParams, err := this.goTypes(cppSlotParams)
+		if err != nil {
+			this.errorf(conn.Pos, "slot %s.%s: %v", conn.Receiver, conn.Slot, err)
+			continue
+		}
 
 		delcaredArgs := make([]string, len(slotParams))
 		for i, paramType := range slotParams {
@@ -1523,7 +2031,7 @@ func (this *compiler) generateSlotOverrideFunctionCode() string {
 	// TODO: Add code here
 }`, goSlot,
 			strings.Join(delcaredArgs, ", "),
-			this.widget.Class,
+			this.Widget.Class,
 			goSlot,
 			strings.Join(callArgs, ", "))
 		addCode(code)
@@ -1543,11 +2051,16 @@ func (this *compiler) GenerateTestCode(goFile string, genPackage string) error {
 		genPackage = `"` + genPackage + `"`
 	}
 
-	var widgetType string = this.widget.Class[1:]
-	if this.widget.Class == "QMainWindow" {
+	var widgetType string = this.Widget.Class[1:]
+	if this.Widget.Class == "QMainWindow" {
 		widgetType = "Window"
 	}
 
+	handlerArg := ""
+	if this.ConnectSlotsByName {
+		handlerArg = ", window"
+	}
+
 	var code string
 	if this.needSubclassing() {
 		code = fmt.Sprintf(`package main
@@ -1568,7 +2081,7 @@ type Window struct {
 func NewWidget(parent widgets.QWidget_ITF) *Window {
 	window := NewWindow(parent, core.Qt__%s)
 
-	window.SetupUI(&window.%s)
+	window.SetupUI(&window.%s%s)
 	return window
 }
 
@@ -1589,11 +2102,12 @@ func main() {
 	os.Exit(app.Exec())
 }
 `, genPackage,
-			this.widget.Class,
+			this.Widget.Class,
 			uiPackage,
 			this.getClassName(),
 			widgetType,
-			this.widget.Class,
+			this.Widget.Class,
+			handlerArg,
 			this.generateSlotOverrideFunctionCode(),
 		)
 	} else {
@@ -1616,7 +2130,7 @@ func NewWidget(parent widgets.QWidget_ITF) *Window {
 		Widget: widgets.New%s(parent, core.Qt__%s),
 	}
 
-	window.SetupUI(window.Widget)
+	window.SetupUI(window.Widget%s)
 	return window
 }
 
@@ -1635,12 +2149,11 @@ func main() {
 `, genPackage,
 			uiPackage,
 			this.getClassName(),
-			this.widget.Class,
-			this.widget.Class,
-			widgetType)
+			this.Widget.Class,
+			this.Widget.Class,
+			widgetType,
+			handlerArg)
 	}
 
-	dir := filepath.Dir(goFile)
-	os.MkdirAll(dir, 0755)
-	return ioutil.WriteFile(goFile, []byte(code), 0644)
+	return writeSource(goFile, code)
 }
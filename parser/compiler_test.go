@@ -0,0 +1,73 @@
+package parser
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("TestToolBox", func() {
+	It("parses page attributes", func() {
+		ui, err := ParseFile("testdata/toolbox.ui")
+		Expect(err).NotTo(HaveOccurred())
+
+		toolBox := ui.Widget.Layout.Items[0].View.(*QWidget)
+		page := toolBox.Widgets[1]
+		Expect(page.Attributes[0].Value).To(Equal(&String{Value: "Advanced"}))
+		Expect(page.Attributes[1].Value.(*QIcon).NormalOff).To(Equal("images/checked.png"))
+	})
+
+	It("adds the pages", func() {
+		code, diagnostics := compile("testdata/toolbox.ui", nil)
+		for _, line := range []string{
+			`this.ToolBox = widgets.NewQToolBox(Form, core.Qt__Widget)`,
+			`this.ToolBox.AddItem2(this.GeneralPage, "")`,
+			`this.ToolBox.AddItem(this.AdvancedPage, icon, "")`,
+			`this.ToolBox.SetItemText(this.ToolBox.IndexOf(this.GeneralPage), _translate("Form", "General", "", -1))`,
+			`this.ToolBox.SetItemToolTip(this.ToolBox.IndexOf(this.GeneralPage), _translate("Form", "General settings", "", -1))`,
+			`this.ToolBox.SetItemText(this.ToolBox.IndexOf(this.AdvancedPage), _translate("Form", "Advanced", "", -1))`,
+		} {
+			Expect(code).To(ContainSubstring("\t" + line + "\n"))
+		}
+		Expect(code).To(ContainSubstring("\tthis.RetranslateUi(Form)\n\tthis.ToolBox.SetCurrentIndex(1)\n"))
+		Expect(diagnostics).To(Equal("testdata/toolbox.ui:37:7: warning: unsupported attribute bogus of QToolBox page advancedPage\n"))
+	})
+})
+
+var _ = Describe("TestActionGroup", func() {
+	It("test", func() {
+		code := generate("testdata/actiongroup.ui")
+
+		Expect(code).To(MatchRegexp(`ViewModeGroup\s+\*widgets.QActionGroup`))
+		Expect(code).To(ContainSubstring(`this.ViewModeGroup = widgets.NewQActionGroup(MainWindow)
+	this.ViewModeGroup.SetObjectName("viewModeGroup")
+	this.ViewModeGroup.SetExclusive(true)
+	this.ViewModeGroup.SetEnabled(false)`))
+		Expect(code).To(ContainSubstring("this.ViewModeGroup.AddAction(this.ActionList)"))
+		Expect(code).To(ContainSubstring("this.ViewModeGroup.AddAction(this.ActionIcons)"))
+
+		Expect(code).To(ContainSubstring(`this.ZoomGroup = widgets.NewQActionGroup(this.ViewModeGroup)
+	this.ZoomGroup.SetObjectName("zoomGroup")
+	this.ZoomGroup.SetVisible(false)`))
+		Expect(code).To(ContainSubstring("this.ZoomGroup.AddAction(this.ActionZoomIn)"))
+		Expect(code).To(ContainSubstring("this.MenuView.QWidget.AddAction(this.ActionZoomIn)"))
+	})
+})
+
+var _ = Describe("TestTabWidget", func() {
+	It("adds the tabs", func() {
+		code, diagnostics := compile("testdata/tabwidget.ui", nil)
+		for _, line := range []string{
+			`this.TabWidget.AddTab(this.GeneralTab, "")`,
+			`this.TabWidget.SetTabWhatsThis(this.TabWidget.IndexOf(this.GeneralTab), "general")`,
+			`this.TabWidget.AddTab2(this.AdvancedTab, icon, "")`,
+			`this.TabWidget.SetTabText(this.TabWidget.IndexOf(this.GeneralTab), _translate("Form", "General", "", -1))`,
+			`this.TabWidget.SetTabToolTip(this.TabWidget.IndexOf(this.GeneralTab), _translate("Form", "General settings", "", -1))`,
+			`this.TabWidget.SetTabText(this.TabWidget.IndexOf(this.AdvancedTab), _translate("Form", "Advanced", "", -1))`,
+		} {
+			Expect(code).To(ContainSubstring("\t" + line + "\n"))
+		}
+		Expect(diagnostics).To(Equal("testdata/tabwidget.ui:28:7: warning: unsupported attribute bogus of QTabWidget page advancedTab\n"))
+
+		typeCheck("testdata/tabwidget.ui")
+	})
+})
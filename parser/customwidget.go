@@ -0,0 +1,62 @@
+package parser
+
+import (
+	"strings"
+)
+
+// customWidget returns the custom widget declaration of class, nil if class
+// is not a promoted widget.
+func (this *compiler) customWidget(class string) *CustomWidget {
+	for _, cw := range this.CustomWidgets {
+		if cw.Class == class {
+			return cw
+		}
+	}
+	return nil
+}
+
+// baseClass returns the Qt class a promoted widget extends. Classes that are
+// not promoted are returned unchanged.
+func (this *compiler) baseClass(class string) string {
+	seen := map[string]bool{}
+	for {
+		cw := this.customWidget(class)
+		if cw == nil || cw.Extends == "" || seen[class] {
+			return class
+		}
+		seen[class] = true
+		class = cw.Extends
+	}
+}
+
+// customWidgetImport returns the import path of the Go package declaring a
+// promoted widget, "" for the package of the generated code.
+//
+// A header ending in ".h" refers to the package of the generated code. Any
+// other header is the import path of the package, optionally followed by
+// ";<name>" if its name cannot be looked up, e.g.
+// "github.com/example/go-gauges;gauges".
+func (this *compiler) customWidgetImport(cw *CustomWidget) string {
+	if cw.Header == "" || strings.HasSuffix(cw.Header, ".h") {
+		return ""
+	}
+	i := strings.Index(cw.Header, ";")
+	if i < 0 {
+		return cw.Header
+	}
+
+	_import := strings.TrimSpace(cw.Header[:i])
+	if name := strings.TrimSpace(cw.Header[i+1:]); name != "" {
+		this.packageNames[_import] = name
+	}
+	return _import
+}
+
+// customWidgetType returns the Go type and constructor of a promoted widget.
+// The package declaring the type, see customWidgetImport, must provide
+//
+//	func New<Class>(parent widgets.QWidget_ITF) *<Class>
+func (this *compiler) customWidgetType(cw *CustomWidget) (goType string, constructor string) {
+	_import := this.customWidgetImport(cw)
+	return this.qualify(_import, cw.Class), this.qualify(_import, "New"+cw.Class)
+}
@@ -0,0 +1,41 @@
+package parser
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("TestCustomWidget", func() {
+	It("test", func() {
+		code := generate("testdata/custom.ui")
+
+		Expect(code).To(ContainSubstring(`"github.com/example/colorwidgets"`))
+		Expect(code).To(MatchRegexp(`ColorButton\s+\*colorwidgets.ColorButton`))
+		Expect(code).To(ContainSubstring("this.ColorButton = colorwidgets.NewColorButton(this.Centralwidget)"))
+		Expect(code).To(ContainSubstring("this.ColorButton.SetText("))
+
+		Expect(code).To(MatchRegexp(`PageView\s+\*pageview.PageView`))
+		Expect(code).To(ContainSubstring("this.PageView.AddPage(this.Page)"))
+		Expect(code).To(ContainSubstring("this.VerticalLayout.AddWidget(this.PageView, 0, 0)"))
+	})
+})
+
+var _ = Describe("TestCustomWidgetPackages", func() {
+	It("qualifies by the package name", func() {
+		code := generate("testdata/packages.ui")
+
+		Expect(code).To(ContainSubstring(`colorwidgets "github.com/example/colorwidgets/v2"`))
+		Expect(code).To(ContainSubstring("this.ColorButton = colorwidgets.NewColorButton(Form)"))
+		Expect(code).To(ContainSubstring(`gauges "github.com/example/go-gauges"`))
+		Expect(code).To(ContainSubstring("this.Gauge = gauges.NewGauge(Form)"))
+		Expect(code).To(ContainSubstring(`gopkgxmlx "github.com/stephenlyu/go-pkg-xmlx"`))
+		Expect(code).To(MatchRegexp(`Document\s+\*gopkgxmlx.Document`))
+	})
+
+	It("turns the path into an identifier", func() {
+		err, compiler := NewCompiler("testdata/packages.ui")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(compiler.packageName("github.com/example/Go-Charts.js")).To(Equal("gochartsjs"))
+		Expect(compiler.packageName("github.com/example/go-charts/v3")).To(Equal("gocharts"))
+	})
+})
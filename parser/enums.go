@@ -0,0 +1,288 @@
+package parser
+
+import (
+	"strings"
+)
+
+// EnumPackages maps the Qt classes declaring enums to the package of the Go
+// binding declaring them, e.g. "core" for Qt::AlignLeft, which becomes
+// core.Qt__AlignLeft. Bare names are therecipe qt modules, other values are
+// import paths, so the enums of custom classes can be added with
+//
+//	parser.EnumPackages["MyWidget"] = "github.com/me/mywidgets"
+//
+// The therecipe entries are checked against the binding by TestEnumPackages.
+// Enums of promoted widgets are found without an entry, see enumPackage.
+var EnumPackages = map[string]string{
+	// core
+	"QAbstractAnimation":      "core",
+	"QAbstractItemModel":      "core",
+	"QAbstractTransition":     "core",
+	"QByteArray":              "core",
+	"QCborError":              "core",
+	"QCborStreamReader":       "core",
+	"QCborValue":              "core",
+	"QChar":                   "core",
+	"QCommandLineOption":      "core",
+	"QCommandLineParser":      "core",
+	"QCryptographicHash":      "core",
+	"QDataStream":             "core",
+	"QDate":                   "core",
+	"QDeadlineTimer":          "core",
+	"QDebug":                  "core",
+	"QDir":                    "core",
+	"QDirIterator":            "core",
+	"QEasingCurve":            "core",
+	"QElapsedTimer":           "core",
+	"QEvent":                  "core",
+	"QEventLoop":              "core",
+	"QFileDevice":             "core",
+	"QHistoryState":           "core",
+	"QIODevice":               "core",
+	"QItemSelectionModel":     "core",
+	"QJsonDocument":           "core",
+	"QJsonParseError":         "core",
+	"QJsonValue":              "core",
+	"QLibrary":                "core",
+	"QLibraryInfo":            "core",
+	"QLineF":                  "core",
+	"QLocale":                 "core",
+	"QLockFile":               "core",
+	"QMetaMethod":             "core",
+	"QMetaType":               "core",
+	"QMimeDatabase":           "core",
+	"QMutex":                  "core",
+	"QOperatingSystemVersion": "core",
+	"QProcess":                "core",
+	"QReadWriteLock":          "core",
+	"QRegExp":                 "core",
+	"QRegularExpression":      "core",
+	"QResource":               "core",
+	"QSettings":               "core",
+	"QSharedMemory":           "core",
+	"QSocketNotifier":         "core",
+	"QStandardPaths":          "core",
+	"QState":                  "core",
+	"QStateMachine":           "core",
+	"QString":                 "core",
+	"QSysInfo":                "core",
+	"QSystemSemaphore":        "core",
+	"QTextBoundaryFinder":     "core",
+	"QTextCodec":              "core",
+	"QTextStream":             "core",
+	"QThread":                 "core",
+	"QTimeLine":               "core",
+	"QTimeZone":               "core",
+	"QUrl":                    "core",
+	"QUuid":                   "core",
+	"QVariant":                "core",
+	"QXmlStreamReader":        "core",
+	"Qt":                      "core",
+	// gui
+	"QAccessible":                      "gui",
+	"QAccessibleTableModelChangeEvent": "gui",
+	"QClipboard":                       "gui",
+	"QColor":                           "gui",
+	"QContextMenuEvent":                "gui",
+	"QDoubleValidator":                 "gui",
+	"QFont":                            "gui",
+	"QFontDatabase":                    "gui",
+	"QGlyphRun":                        "gui",
+	"QGradient":                        "gui",
+	"QIcon":                            "gui",
+	"QIconEngine":                      "gui",
+	"QImage":                           "gui",
+	"QImageIOHandler":                  "gui",
+	"QImageIOPlugin":                   "gui",
+	"QImageReader":                     "gui",
+	"QImageWriter":                     "gui",
+	"QInputMethod":                     "gui",
+	"QInputMethodEvent":                "gui",
+	"QKeySequence":                     "gui",
+	"QMovie":                           "gui",
+	"QOpenGLBuffer":                    "gui",
+	"QOpenGLContext":                   "gui",
+	"QOpenGLDebugLogger":               "gui",
+	"QOpenGLDebugMessage":              "gui",
+	"QOpenGLFramebufferObject":         "gui",
+	"QOpenGLFunctions":                 "gui",
+	"QOpenGLShader":                    "gui",
+	"QOpenGLTexture":                   "gui",
+	"QOpenGLTextureBlitter":            "gui",
+	"QOpenGLWindow":                    "gui",
+	"QPageLayout":                      "gui",
+	"QPageSize":                        "gui",
+	"QPagedPaintDevice":                "gui",
+	"QPaintDevice":                     "gui",
+	"QPaintEngine":                     "gui",
+	"QPainter":                         "gui",
+	"QPainterPath":                     "gui",
+	"QPalette":                         "gui",
+	"QPixelFormat":                     "gui",
+	"QPlatformSurfaceEvent":            "gui",
+	"QRawFont":                         "gui",
+	"QRegion":                          "gui",
+	"QScrollEvent":                     "gui",
+	"QSessionManager":                  "gui",
+	"QStandardItem":                    "gui",
+	"QStaticText":                      "gui",
+	"QSurface":                         "gui",
+	"QSurfaceFormat":                   "gui",
+	"QTabletEvent":                     "gui",
+	"QTextBlockFormat":                 "gui",
+	"QTextCharFormat":                  "gui",
+	"QTextCursor":                      "gui",
+	"QTextDocument":                    "gui",
+	"QTextFormat":                      "gui",
+	"QTextFrameFormat":                 "gui",
+	"QTextItem":                        "gui",
+	"QTextLayout":                      "gui",
+	"QTextLength":                      "gui",
+	"QTextLine":                        "gui",
+	"QTextListFormat":                  "gui",
+	"QTextOption":                      "gui",
+	"QTouchDevice":                     "gui",
+	"QTransform":                       "gui",
+	"QValidator":                       "gui",
+	"QVulkanInstance":                  "gui",
+	"QVulkanWindow":                    "gui",
+	"QWindow":                          "gui",
+	// widgets
+	"QAbstractItemDelegate":          "widgets",
+	"QAbstractItemView":              "widgets",
+	"QAbstractScrollArea":            "widgets",
+	"QAbstractSlider":                "widgets",
+	"QAbstractSpinBox":               "widgets",
+	"QAction":                        "widgets",
+	"QBoxLayout":                     "widgets",
+	"QCalendarWidget":                "widgets",
+	"QColorDialog":                   "widgets",
+	"QColormap":                      "widgets",
+	"QComboBox":                      "widgets",
+	"QCompleter":                     "widgets",
+	"QDataWidgetMapper":              "widgets",
+	"QDateTimeEdit":                  "widgets",
+	"QDialog":                        "widgets",
+	"QDialogButtonBox":               "widgets",
+	"QDirModel":                      "widgets",
+	"QDockWidget":                    "widgets",
+	"QFileDialog":                    "widgets",
+	"QFileIconProvider":              "widgets",
+	"QFileSystemModel":               "widgets",
+	"QFontComboBox":                  "widgets",
+	"QFontDialog":                    "widgets",
+	"QFormLayout":                    "widgets",
+	"QFrame":                         "widgets",
+	"QGesture":                       "widgets",
+	"QGestureRecognizer":             "widgets",
+	"QGraphicsBlurEffect":            "widgets",
+	"QGraphicsEffect":                "widgets",
+	"QGraphicsEllipseItem":           "widgets",
+	"QGraphicsItem":                  "widgets",
+	"QGraphicsItemGroup":             "widgets",
+	"QGraphicsLineItem":              "widgets",
+	"QGraphicsPathItem":              "widgets",
+	"QGraphicsPixmapItem":            "widgets",
+	"QGraphicsPolygonItem":           "widgets",
+	"QGraphicsProxyWidget":           "widgets",
+	"QGraphicsRectItem":              "widgets",
+	"QGraphicsScene":                 "widgets",
+	"QGraphicsSceneContextMenuEvent": "widgets",
+	"QGraphicsSimpleTextItem":        "widgets",
+	"QGraphicsTextItem":              "widgets",
+	"QGraphicsView":                  "widgets",
+	"QGraphicsWidget":                "widgets",
+	"QHeaderView":                    "widgets",
+	"QInputDialog":                   "widgets",
+	"QLCDNumber":                     "widgets",
+	"QLayout":                        "widgets",
+	"QLineEdit":                      "widgets",
+	"QListView":                      "widgets",
+	"QListWidgetItem":                "widgets",
+	"QMainWindow":                    "widgets",
+	"QMdiArea":                       "widgets",
+	"QMdiSubWindow":                  "widgets",
+	"QMessageBox":                    "widgets",
+	"QOpenGLWidget":                  "widgets",
+	"QPinchGesture":                  "widgets",
+	"QPlainTextEdit":                 "widgets",
+	"QProgressBar":                   "widgets",
+	"QRubberBand":                    "widgets",
+	"QScroller":                      "widgets",
+	"QScrollerProperties":            "widgets",
+	"QSizePolicy":                    "widgets",
+	"QSlider":                        "widgets",
+	"QStackedLayout":                 "widgets",
+	"QStyle":                         "widgets",
+	"QStyleHintReturn":               "widgets",
+	"QStyleHintReturnMask":           "widgets",
+	"QStyleHintReturnVariant":        "widgets",
+	"QStyleOption":                   "widgets",
+	"QStyleOptionButton":             "widgets",
+	"QStyleOptionComboBox":           "widgets",
+	"QStyleOptionComplex":            "widgets",
+	"QStyleOptionDockWidget":         "widgets",
+	"QStyleOptionFocusRect":          "widgets",
+	"QStyleOptionFrame":              "widgets",
+	"QStyleOptionGraphicsItem":       "widgets",
+	"QStyleOptionGroupBox":           "widgets",
+	"QStyleOptionHeader":             "widgets",
+	"QStyleOptionMenuItem":           "widgets",
+	"QStyleOptionProgressBar":        "widgets",
+	"QStyleOptionRubberBand":         "widgets",
+	"QStyleOptionSizeGrip":           "widgets",
+	"QStyleOptionSlider":             "widgets",
+	"QStyleOptionSpinBox":            "widgets",
+	"QStyleOptionTab":                "widgets",
+	"QStyleOptionTabBarBase":         "widgets",
+	"QStyleOptionTabWidgetFrame":     "widgets",
+	"QStyleOptionTitleBar":           "widgets",
+	"QStyleOptionToolBar":            "widgets",
+	"QStyleOptionToolBox":            "widgets",
+	"QStyleOptionToolButton":         "widgets",
+	"QStyleOptionViewItem":           "widgets",
+	"QSwipeGesture":                  "widgets",
+	"QSystemTrayIcon":                "widgets",
+	"QTabBar":                        "widgets",
+	"QTabWidget":                     "widgets",
+	"QTableWidgetItem":               "widgets",
+	"QTextEdit":                      "widgets",
+	"QToolButton":                    "widgets",
+	"QTreeWidgetItem":                "widgets",
+	"QTreeWidgetItemIterator":        "widgets",
+	"QWidget":                        "widgets",
+	"QWizard":                        "widgets",
+}
+
+// enumPackage returns the import path of the package declaring the enums of
+// class, "" for the package of the generated code.
+func (this *compiler) enumPackage(class string) (_import string, ok bool) {
+	if _import, ok := EnumPackages[class]; ok {
+		return _import, true
+	}
+	if cw := this.customWidget(class); cw != nil {
+		return this.customWidgetImport(cw), true
+	}
+	return "", false
+}
+
+// enumToString returns the Go constant of the enum value enum, e.g.
+// widgets.QTabWidget__North for QTabWidget::North. It reports an unknown enum
+// and returns "", callers skip the code using it then.
+func (this *compiler) enumToString(pos Position, enum string) string {
+	parts := strings.Split(enum, "::")
+	if len(parts) < 2 {
+		this.errorf(pos, "unknown enum %s", enum)
+		return ""
+	}
+
+	// Scoped enums may be written as Class::Enum::Value.
+	class, value := parts[0], parts[len(parts)-1]
+	_import, ok := this.enumPackage(class)
+	if !ok {
+		this.errorf(pos, "unknown enum %s", enum)
+		return ""
+	}
+
+	return this.qualify(_import, class+"__"+value)
+}
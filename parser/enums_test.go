@@ -0,0 +1,52 @@
+package parser
+
+import (
+	"bytes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("TestEnumPackages", func() {
+	It("matches the binding", func() {
+		for class, pkg := range EnumPackages {
+			Expect(bindingSource(pkg)).To(ContainSubstring("\ntype "+class+"__"), class)
+		}
+		for class, pkg := range ClassPackages {
+			Expect(bindingSource(pkg)).To(ContainSubstring("\ntype "+class+" struct"), class)
+		}
+	})
+
+	It("translates enums", func() {
+		err, compiler := NewCompiler("testdata/custom.ui")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(compiler.Parse()).To(Succeed())
+		buf := &bytes.Buffer{}
+		compiler.Diagnostics = buf
+
+		for enum, expected := range map[string]string{
+			"Qt::AlignLeft":                  "core.Qt__AlignLeft",
+			"QAbstractSpinBox::UpDownArrows": "widgets.QAbstractSpinBox__UpDownArrows",
+			"QTabWidget::North":              "widgets.QTabWidget__North",
+			"QSlider::TicksBelow":            "widgets.QSlider__TicksBelow",
+			"QTextEdit::WidgetWidth":         "widgets.QTextEdit__WidgetWidth",
+			"QSizePolicy::Expanding":         "widgets.QSizePolicy__Expanding",
+			"QToolButton::InstantPopup":      "widgets.QToolButton__InstantPopup",
+			"QComboBox::AdjustToContents":    "widgets.QComboBox__AdjustToContents",
+			"QPalette::Window":               "gui.QPalette__Window",
+			"QSizePolicy::Policy::Fixed":     "widgets.QSizePolicy__Fixed",
+			"ColorButton::Round":             "colorwidgets.ColorButton__Round",
+		} {
+			Expect(compiler.enumToString(Position{}, enum)).To(Equal(expected))
+		}
+		Expect(compiler.Imports).To(HaveKey("github.com/therecipe/qt/gui"))
+		Expect(compiler.Imports).To(HaveKey("github.com/example/colorwidgets"))
+
+		EnumPackages["MyClass"] = "github.com/example/my"
+		defer delete(EnumPackages, "MyClass")
+		Expect(compiler.enumToString(Position{}, "MyClass::Value")).To(Equal("my.MyClass__Value"))
+
+		Expect(buf.String()).To(BeEmpty())
+		Expect(compiler.enumToString(Position{}, "Bogus::Value")).To(Equal(""))
+		Expect(buf.String()).To(Equal("unknown enum Bogus::Value\n"))
+	})
+})
@@ -0,0 +1,62 @@
+package parser
+
+import (
+	"fmt"
+	xmlx "github.com/stephenlyu/go-pkg-xmlx"
+	"strings"
+)
+
+// MaxErrors is the number of errors collected by Parse before it gives up.
+var MaxErrors = 10
+
+// ParseError describes a problem found in a .ui file.
+type ParseError struct {
+	File         string // .ui file name
+	Line, Column int    // 1-based, zero if unknown
+	Path         string // element path, e.g. ui/widget[Dialog]/property[geometry]
+	Reason       string
+}
+
+// Error returns the error in the file:line:col: path: reason form, without
+// the parts that are unknown.
+func (this *ParseError) Error() string {
+	parts := []string{}
+	if pos := (Position{File: this.File, Line: this.Line, Column: this.Column}).String(); pos != "" {
+		parts = append(parts, pos)
+	}
+	if this.Path != "" {
+		parts = append(parts, this.Path)
+	}
+	return strings.Join(append(parts, this.Reason), ": ")
+}
+
+// ParseErrors is the list of errors returned by Parse.
+type ParseErrors []*ParseError
+
+func (this ParseErrors) Error() string {
+	lines := make([]string, len(this))
+	for i, e := range this {
+		lines[i] = e.Error()
+	}
+	return strings.Join(lines, "\n")
+}
+
+// bailout is used to stop parsing once MaxErrors errors have been collected.
+type bailout struct{}
+
+// elementPath returns the path of n from the document root. Elements with a
+// name attribute are qualified with it.
+func elementPath(n *xmlx.Node) string {
+	parts := []string{}
+	for ; n != nil; n = n.Parent {
+		if n.Type != xmlx.NT_ELEMENT {
+			continue
+		}
+		part := n.Name.Local
+		if name := n.As("", "name"); name != "" {
+			part = fmt.Sprintf("%s[%s]", part, name)
+		}
+		parts = append([]string{part}, parts...)
+	}
+	return strings.Join(parts, "/")
+}
@@ -0,0 +1,73 @@
+package parser
+
+import (
+	"fmt"
+	"go/format"
+	"go/scanner"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// snippetContext is the number of lines shown around the offending line of
+// generated code that does not parse.
+const snippetContext = 3
+
+// formatSource formats the generated code of goFile with go/format. Generated
+// code that does not parse is a compiler bug, the error shows the offending
+// lines.
+func formatSource(goFile string, code string) ([]byte, error) {
+	src, err := format.Source([]byte(code))
+	if err == nil {
+		return src, nil
+	}
+
+	line := 0
+	if list, ok := err.(scanner.ErrorList); ok && len(list) > 0 {
+		line = list[0].Pos.Line
+	}
+	return nil, fmt.Errorf("%s: internal compiler error, please report this goqtuic bug: generated code does not parse: %v\n%s",
+		goFile, err, snippet(code, line))
+}
+
+// snippet returns the lines of code around line, numbered and with the line
+// itself marked.
+func snippet(code string, line int) string {
+	lines := strings.Split(code, "\n")
+	if line <= 0 || line > len(lines) {
+		return ""
+	}
+
+	from, to := line-snippetContext, line+snippetContext
+	if from < 1 {
+		from = 1
+	}
+	if to > len(lines) {
+		to = len(lines)
+	}
+
+	buf := []string{}
+	for i := from; i <= to; i++ {
+		marker := " "
+		if i == line {
+			marker = ">"
+		}
+		buf = append(buf, fmt.Sprintf("%s%5d  %s", marker, i, lines[i-1]))
+	}
+	return strings.Join(buf, "\n")
+}
+
+// writeSource formats code and writes it to goFile. Nothing is written if the
+// code does not parse.
+func writeSource(goFile string, code string) error {
+	src, err := formatSource(goFile, code)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(goFile), 0755); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(goFile, src, 0644)
+}
@@ -0,0 +1,60 @@
+package parser
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"go/format"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+var _ = Describe("TestFormat", func() {
+	It("generates gofmt clean code", func() {
+		for _, uiFile := range []string{"../sample/ui/test.ui", "../sample/ui/test_main_window.ui", "../sample/ui/test_signal_slot.ui"} {
+			code := generate(uiFile)
+			src, err := format.Source([]byte(code))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(code).To(Equal(string(src)), uiFile)
+		}
+	})
+
+	It("reports code that does not parse", func() {
+		dir, err := ioutil.TempDir("", "goqtuic")
+		Expect(err).NotTo(HaveOccurred())
+		defer os.RemoveAll(dir)
+
+		goFile := filepath.Join(dir, "ui.go")
+		err = writeSource(goFile, "package main\n\nfunc f() {\n\tthis.X.SetText(\"a\"\n}\n")
+		Expect(err).To(MatchError(ContainSubstring("internal compiler error")))
+		Expect(err).To(MatchError(ContainSubstring(">    4  \tthis.X.SetText(\"a\"")))
+		_, err = os.Stat(goFile)
+		Expect(os.IsNotExist(err)).To(BeTrue())
+	})
+
+	It("reports directories that cannot be created", func() {
+		dir, err := ioutil.TempDir("", "goqtuic")
+		Expect(err).NotTo(HaveOccurred())
+		defer os.RemoveAll(dir)
+
+		file := filepath.Join(dir, "file")
+		Expect(ioutil.WriteFile(file, nil, 0644)).To(Succeed())
+		err = writeSource(filepath.Join(file, "ui", "ui.go"), "package main\n")
+		Expect(err).To(MatchError(ContainSubstring("not a directory")))
+	})
+})
+
+var _ = Describe("TestDeterministic", func() {
+	It("generates the same code for the same ui file", func() {
+		uiFiles, err := filepath.Glob("../sample/ui/*.ui")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(uiFiles).NotTo(BeEmpty())
+
+		for _, uiFile := range uiFiles {
+			code := generate(uiFile)
+			for i := 0; i < 5; i++ {
+				Expect(generate(uiFile)).To(Equal(code), uiFile)
+			}
+		}
+	})
+})
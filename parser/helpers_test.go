@@ -0,0 +1,71 @@
+package parser
+
+import (
+	"bytes"
+	. "github.com/onsi/gomega"
+	"go/build"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+)
+
+// bindingPackages lists the packages of the binding the generated code uses.
+var bindingPackages = []string{"core", "gui", "widgets"}
+
+var bindingSources = map[string]string{}
+
+// bindingSource returns the source of the binding package pkg.
+func bindingSource(pkg string) string {
+	if source, ok := bindingSources[pkg]; ok {
+		return source
+	}
+
+	p, err := build.Import("github.com/therecipe/qt/"+pkg, ".", build.FindOnly)
+	Expect(err).NotTo(HaveOccurred())
+	data, err := ioutil.ReadFile(filepath.Join(p.Dir, pkg+".go"))
+	Expect(err).NotTo(HaveOccurred())
+	bindingSources[pkg] = string(data)
+	return bindingSources[pkg]
+}
+
+// compile compiles uiFile after setup, if any, adjusted the compiler and returns the generated code and diagnostics.
+func compile(uiFile string, setup func(compiler *compiler)) (string, string) {
+	err, compiler := NewCompiler(uiFile)
+	Expect(err).NotTo(HaveOccurred())
+	Expect(compiler.Parse()).To(Succeed())
+	buf := &bytes.Buffer{}
+	compiler.Diagnostics = buf
+	if setup != nil {
+		setup(compiler)
+	}
+
+	// The code is generated even if errors were reported, to check it.
+	src, err := formatSource("ui.go", compiler.generateCode("main"))
+	Expect(err).NotTo(HaveOccurred())
+	return string(src), buf.String()
+}
+
+// generate compiles uiFile and returns the generated code.
+func generate(uiFile string) string {
+	code, _ := compile(uiFile, nil)
+	return code
+}
+
+// typeCheck compiles uiFile into a package of this module and vets it, so
+// that the generated code is checked against the binding.
+func typeCheck(uiFile string) {
+	err, compiler := NewCompiler(uiFile)
+	Expect(err).NotTo(HaveOccurred())
+	Expect(compiler.Parse()).To(Succeed())
+	compiler.Diagnostics = ioutil.Discard
+
+	// go ignores directories starting with _ in ./... patterns.
+	dir, err := ioutil.TempDir(".", "_typecheck")
+	Expect(err).NotTo(HaveOccurred())
+	defer os.RemoveAll(dir)
+
+	Expect(writeSource(filepath.Join(dir, "ui.go"), compiler.generateCode("typecheck"))).To(Succeed())
+	out, err := exec.Command("go", "vet", "./"+filepath.ToSlash(dir)).CombinedOutput()
+	Expect(err).NotTo(HaveOccurred(), string(out))
+}
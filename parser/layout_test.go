@@ -0,0 +1,143 @@
+package parser
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("TestAlignment", func() {
+	It("parses item alignments", func() {
+		ui, err := ParseFile("testdata/alignment.ui")
+		Expect(err).NotTo(HaveOccurred())
+
+		grid := ui.Widget.Layout.Items[1].View.(*QLayout)
+		Expect(grid.Items[0].Alignment).To(Equal("Qt::AlignRight|Qt::AlignVCenter"))
+		Expect(grid.Items[1].Alignment).To(Equal(""))
+	})
+
+	It("places grid spacers at their cell", func() {
+		code := generate("testdata/alignment.ui")
+		Expect(code).To(ContainSubstring("this.GridLayout.AddItem(this.VerticalSpacer, 1, 0, 1, 2, 0)\n"))
+	})
+
+	It("aligns items of box, grid and form layouts", func() {
+		code := generate("testdata/alignment.ui")
+		for _, line := range []string{
+			"this.VerticalLayout.AddWidget(this.Title, 0, core.Qt__AlignHCenter)",
+			"this.GridLayout.AddWidget3(this.NameLabel, 0, 0, 1, 1, core.Qt__AlignRight|core.Qt__AlignVCenter)",
+			"this.GridLayout.AddWidget3(this.NameEdit, 0, 1, 1, 1, 0)",
+			"this.VerticalLayout.SetAlignment2(this.GridLayout, core.Qt__AlignRight)",
+			"this.FormLayout.SetAlignment(this.NotesLabel, core.Qt__AlignTop)",
+		} {
+			Expect(code).To(ContainSubstring("\t" + line + "\n"))
+		}
+	})
+})
+
+var _ = Describe("TestStretch", func() {
+	It("passes box layout stretch factors to the add calls", func() {
+		code := generate("testdata/stretch.ui")
+		for _, line := range []string{
+			"this.HorizontalLayout.AddWidget(this.List, 1, core.Qt__AlignTop)",
+			"this.HorizontalLayout.AddItem(this.HorizontalSpacer)",
+			"this.HorizontalLayout.AddLayout(this.VerticalLayout, 2)",
+			"this.HorizontalLayout.AddWidget(this.CancelButton, 0, 0)",
+			"this.VerticalLayout.AddWidget(this.OkButton, 0, 0)",
+			"this.VerticalLayout.SetStretch(1, 3)",
+		} {
+			Expect(code).To(ContainSubstring("\t" + line + "\n"))
+		}
+		Expect(code).NotTo(ContainSubstring("this.HorizontalLayout.SetStretch"))
+	})
+
+	It("sets the enum properties of layouts", func() {
+		code := generate("testdata/stretch.ui")
+		for _, line := range []string{
+			"this.HorizontalLayout.SetSizeConstraint(widgets.QLayout__SetFixedSize)",
+			"this.VerticalLayout.SetSizeConstraint(widgets.QLayout__SetMinimumSize)",
+			"this.VerticalLayout.SetDirection(widgets.QBoxLayout__BottomToTop)",
+		} {
+			Expect(code).To(ContainSubstring("\t" + line + "\n"))
+		}
+	})
+})
+
+var _ = Describe("TestFormLayout", func() {
+	It("places items by role", func() {
+		code := generate("testdata/formlayout.ui")
+		for _, line := range []string{
+			"this.FormLayout.SetWidget(0, widgets.QFormLayout__LabelRole, this.NameLabel)",
+			"this.FormLayout.SetWidget(0, widgets.QFormLayout__FieldRole, this.NameEdit)",
+			"this.FormLayout.SetWidget(1, widgets.QFormLayout__SpanningRole, this.RememberBox)",
+			"this.FormLayout.SetLayout(2, widgets.QFormLayout__SpanningRole, this.ButtonLayout)",
+			"this.FormLayout.SetItem(3, widgets.QFormLayout__FieldRole, this.VerticalSpacer)",
+		} {
+			Expect(code).To(ContainSubstring("\t" + line + "\n"))
+		}
+	})
+
+	It("sets the form layout properties", func() {
+		code := generate("testdata/formlayout.ui")
+		for _, line := range []string{
+			"this.FormLayout.SetFieldGrowthPolicy(widgets.QFormLayout__AllNonFixedFieldsGrow)",
+			"this.FormLayout.SetRowWrapPolicy(widgets.QFormLayout__WrapLongRows)",
+			"this.FormLayout.SetLabelAlignment(core.Qt__AlignRight | core.Qt__AlignTrailing | core.Qt__AlignVCenter)",
+			"this.FormLayout.SetFormAlignment(core.Qt__AlignHCenter | core.Qt__AlignTop)",
+			"this.FormLayout.SetHorizontalSpacing(12)",
+			"this.FormLayout.SetVerticalSpacing(6)",
+		} {
+			Expect(code).To(ContainSubstring("\t" + line + "\n"))
+		}
+	})
+})
+
+var _ = Describe("TestLayoutDefaults", func() {
+	It("parses layoutdefault and layoutfunction", func() {
+		ui, err := ParseFile("testdata/layoutfunction.ui")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ui.LayoutDefault).To(Equal(&LayoutDefault{Spacing: 6, Margin: 11}))
+		Expect(ui.LayoutFunction).To(Equal(&LayoutFunction{Spacing: "layoutSpacing", Margin: "layoutMargin"}))
+	})
+
+	It("keeps the style defaults if the form sets none", func() {
+		code := generate("testdata/alignment.ui")
+		Expect(code).NotTo(ContainSubstring("this.VerticalLayout.SetContentsMargins"))
+		Expect(code).NotTo(ContainSubstring("SetSpacing"))
+		Expect(code).To(ContainSubstring("\tthis.GridLayout = widgets.NewQGridLayout(nil)\n"))
+		Expect(code).To(ContainSubstring("\tthis.GridLayout.SetContentsMargins(0, 0, 0, 0)\n"))
+	})
+
+	It("generates nested layouts that type-check", func() {
+		typeCheck("testdata/alignment.ui")
+	})
+
+	It("applies layoutdefault to the layouts of widgets only", func() {
+		code := generate("testdata/layoutdefault.ui")
+		for _, line := range []string{
+			"this.VerticalLayout = widgets.NewQVBoxLayout2(Form)",
+			"this.VerticalLayout.SetContentsMargins(9, 9, 9, 9)",
+			"this.VerticalLayout.SetSpacing(6)",
+			"this.GroupLayout = widgets.NewQVBoxLayout2(this.GroupBox)",
+			"this.GroupLayout.SetContentsMargins(9, 9, 9, 9)",
+			"this.OptionLayout = widgets.NewQHBoxLayout2(nil)",
+			"this.OptionLayout.SetContentsMargins(0, 0, 0, 0)",
+			"this.OptionLayout.SetSpacing(6)",
+		} {
+			Expect(code).To(ContainSubstring("\t" + line + "\n"))
+		}
+	})
+
+	It("calls the layout functions", func() {
+		code := generate("testdata/layoutfunction.ui")
+		for _, line := range []string{
+			"this.VerticalLayout.SetContentsMargins(4, layoutMargin(), layoutMargin(), layoutMargin())",
+			"this.VerticalLayout.SetSpacing(layoutSpacing())",
+			"this.ButtonLayout.SetContentsMargins(0, 0, 0, 0)",
+			"this.ButtonLayout.SetSpacing(layoutSpacing())",
+			"this.StatusLayout.SetContentsMargins(2, 2, 2, 2)",
+			"this.StatusLayout.SetSpacing(3)",
+		} {
+			Expect(code).To(ContainSubstring("\t" + line + "\n"))
+		}
+	})
+})
@@ -4,6 +4,12 @@ type LayoutDefault struct {
 	Spacing, Margin int
 }
 
+// LayoutFunction names the functions returning the default spacing and
+// margin of layouts, "" if not set.
+type LayoutFunction struct {
+	Spacing, Margin string
+}
+
 type QPoint struct {
 	X, Y int
 }
@@ -38,17 +44,19 @@ type Url struct {
 	String string
 }
 
+// QFont is a font property. Attributes not set in the ui file are nil, or ""
+// for strings, and left at their defaults by the generated code.
 type QFont struct {
 	Family        string
-	PointSize     int
-	Weight        int
-	Italic        bool
-	Bold          bool
-	Underline     bool
-	Strikeout     bool
-	AntiAliasing  bool
-	StyleStrategy string
-	Kerning       bool
+	PointSize     *int
+	Weight        *int
+	Italic        *bool
+	Bold          *bool
+	Underline     *bool
+	Strikeout     *bool
+	AntiAliasing  *bool
+	StyleStrategy string // QFont::StyleStrategy value, e.g. "PreferAntialias"
+	Kerning       *bool
 }
 
 type QLocale struct {
@@ -85,14 +93,23 @@ type DateTime struct {
 	Hour, Minute, Second int
 }
 
+// Translation holds the translator attributes of a string.
+type Translation struct {
+	Comment      string // disambiguation
+	ExtraComment string
+	Id           string
+}
+
 type String struct {
 	NotR  bool
 	Value string
+	Translation
 }
 
 type StringList struct {
 	NotR    bool
 	Strings []string
+	Translation
 }
 
 type ResourcePixmap struct {
@@ -111,6 +128,7 @@ type QSizePolicy struct {
 }
 
 type Attribute struct {
+	Pos   Position
 	Name  string
 	Value interface{}
 }
@@ -170,7 +188,8 @@ type QPalette struct {
 }
 
 type QPixmap struct {
-	Value string
+	Value    string
+	Resource string // .qrc file the pixmap comes from
 }
 
 type QIcon struct {
@@ -183,27 +202,32 @@ type QIcon struct {
 	SelectedOff string
 	SelectedOn  string
 
-	Theme string
+	Theme    string
+	Resource string // .qrc file the pixmaps come from
 }
 
 type Property struct {
+	Pos    Position
 	Name   string
 	StdSet bool
 	Value  interface{}
 }
 
 type QSpacer struct {
+	Pos        Position
 	Name       string
 	Properties []*Property
 }
 
 type QWidgetItem struct {
+	Pos         Position
 	Props       []*Property
 	Items       []*QWidgetItem // TODO: what's this?
 	Row, Column int
 }
 
 type QLayoutItem struct {
+	Pos              Position
 	Row, Column      int
 	Rowspan, Colspan int
 	Alignment        string
@@ -211,6 +235,7 @@ type QLayoutItem struct {
 }
 
 type QLayout struct {
+	Pos                Position
 	Class              string
 	Name               string
 	Stretch            string
@@ -233,6 +258,7 @@ type Column struct {
 }
 
 type ActionGroup struct {
+	Pos  Position
 	Name string
 
 	Actions      []*Action
@@ -242,10 +268,12 @@ type ActionGroup struct {
 }
 
 type ActionRef struct {
+	Pos  Position
 	Name string
 }
 
 type Action struct {
+	Pos        Position
 	Name       string
 	Menu       string
 	Props      []*Property
@@ -253,6 +281,7 @@ type Action struct {
 }
 
 type Connection struct {
+	Pos      Position
 	Sender   string
 	Signal   string
 	Receiver string
@@ -262,6 +291,7 @@ type Connection struct {
 type QWidget struct {
 	// No element: class, script, widgetdata
 
+	Pos    Position
 	Class  string
 	Name   string
 	Native bool
@@ -280,3 +310,33 @@ type QWidget struct {
 	AddActions    []*ActionRef
 	ZOrders       []string
 }
+
+type CustomWidget struct {
+	Pos            Position
+	Class          string
+	Extends        string
+	Header         string
+	HeaderLocation string // local or global
+	Container      bool
+	AddPageMethod  string
+}
+
+type Resource struct {
+	Pos      Position
+	Location string // .qrc file, relative to the .ui file
+}
+
+// UI is a parsed .ui document.
+type UI struct {
+	File  string // empty if not parsed from a file
+	Class string
+
+	Widget         *QWidget
+	LayoutDefault  *LayoutDefault
+	LayoutFunction *LayoutFunction
+	Connections    []*Connection
+	TabStops       []string
+	ButtonGroups   []string
+	CustomWidgets  []*CustomWidget
+	Resources      []*Resource
+}
@@ -0,0 +1,3025 @@
+package parser
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Overload is the therecipe function or method implementing an overload of a
+// Qt constructor or method.
+type Overload struct {
+	Name   string   // e.g. NewQSize2, AddWidget3
+	Params []string // Go parameter types, e.g. "*core.QSize"
+}
+
+// Pseudo types of arguments that are untyped constants or enum values, their
+// Go type is the type of the parameter they are passed to.
+const (
+	untypedInt   = "untyped int"
+	untypedFloat = "untyped float"
+	untypedNil   = "untyped nil"
+	anyEnum      = "enum"
+)
+
+// Overloads lists, per class, the therecipe identifiers of the overloaded Qt
+// constructors, setters and add and insert methods, which are told apart by a
+// numeric suffix, e.g. QSize(int, int) is NewQSize2 and
+// QGridLayout::addWidget(QWidget *, int, int, int, int, Qt::Alignment) is
+// AddWidget3. Constructors are listed under the class name; every class of
+// the binding the compiler constructs needs an entry. Entries can be added for
+// custom classes, whose constructors are New<Class> otherwise. Other methods
+// keep their name. The table is maintained by hand: when the compiler needs a
+// class or method not listed, copy its overloads, in the binding's order, from
+// the source of the binding's package. TestOverloads checks the names and
+// parameter types against the binding.
+var Overloads = map[string]map[string][]*Overload{
+	"QAbstractButton": {
+		"QAbstractButton": {
+			{"NewQAbstractButton", []string{"*widgets.QWidget"}},
+		},
+	},
+	"QAbstractItemView": {
+		"QAbstractItemView": {
+			{"NewQAbstractItemView", []string{"*widgets.QWidget"}},
+		},
+	},
+	"QAbstractScrollArea": {
+		"QAbstractScrollArea": {
+			{"NewQAbstractScrollArea", []string{"*widgets.QWidget"}},
+		},
+		"setViewportMargins": {
+			{"SetViewportMargins", []string{"int", "int", "int", "int"}},
+			{"SetViewportMargins2", []string{"*core.QMargins"}},
+		},
+	},
+	"QAbstractSlider": {
+		"QAbstractSlider": {
+			{"NewQAbstractSlider", []string{"*widgets.QWidget"}},
+		},
+	},
+	"QAbstractSpinBox": {
+		"QAbstractSpinBox": {
+			{"NewQAbstractSpinBox", []string{"*widgets.QWidget"}},
+		},
+	},
+	"QAccessibleEvent": {
+		"QAccessibleEvent": {
+			{"NewQAccessibleEvent2", []string{"*core.QObject", "gui.QAccessible__Event"}},
+			{"NewQAccessibleEvent3", []string{"*gui.QAccessibleInterface", "gui.QAccessible__Event"}},
+		},
+	},
+	"QAccessibleTableModelChangeEvent": {
+		"QAccessibleTableModelChangeEvent": {
+			{"NewQAccessibleTableModelChangeEvent", []string{"*core.QObject", "gui.QAccessibleTableModelChangeEvent__ModelChangeType"}},
+			{"NewQAccessibleTableModelChangeEvent2", []string{"*gui.QAccessibleInterface", "gui.QAccessibleTableModelChangeEvent__ModelChangeType"}},
+		},
+	},
+	"QAccessibleTextCursorEvent": {
+		"QAccessibleTextCursorEvent": {
+			{"NewQAccessibleTextCursorEvent", []string{"*core.QObject", "int"}},
+			{"NewQAccessibleTextCursorEvent2", []string{"*gui.QAccessibleInterface", "int"}},
+		},
+	},
+	"QAccessibleTextInsertEvent": {
+		"QAccessibleTextInsertEvent": {
+			{"NewQAccessibleTextInsertEvent", []string{"*core.QObject", "int", "string"}},
+			{"NewQAccessibleTextInsertEvent2", []string{"*gui.QAccessibleInterface", "int", "string"}},
+		},
+	},
+	"QAccessibleTextRemoveEvent": {
+		"QAccessibleTextRemoveEvent": {
+			{"NewQAccessibleTextRemoveEvent", []string{"*core.QObject", "int", "string"}},
+			{"NewQAccessibleTextRemoveEvent2", []string{"*gui.QAccessibleInterface", "int", "string"}},
+		},
+	},
+	"QAccessibleTextSelectionEvent": {
+		"QAccessibleTextSelectionEvent": {
+			{"NewQAccessibleTextSelectionEvent", []string{"*core.QObject", "int", "int"}},
+			{"NewQAccessibleTextSelectionEvent2", []string{"*gui.QAccessibleInterface", "int", "int"}},
+		},
+	},
+	"QAccessibleTextUpdateEvent": {
+		"QAccessibleTextUpdateEvent": {
+			{"NewQAccessibleTextUpdateEvent", []string{"*core.QObject", "int", "string", "string"}},
+			{"NewQAccessibleTextUpdateEvent2", []string{"*gui.QAccessibleInterface", "int", "string", "string"}},
+		},
+	},
+	"QAccessibleValueChangeEvent": {
+		"QAccessibleValueChangeEvent": {
+			{"NewQAccessibleValueChangeEvent", []string{"*core.QObject", "*core.QVariant"}},
+			{"NewQAccessibleValueChangeEvent2", []string{"*gui.QAccessibleInterface", "*core.QVariant"}},
+		},
+	},
+	"QAction": {
+		"QAction": {
+			{"NewQAction", []string{"*core.QObject"}},
+			{"NewQAction2", []string{"string", "*core.QObject"}},
+			{"NewQAction3", []string{"*gui.QIcon", "string", "*core.QObject"}},
+		},
+		"setShortcuts": {
+			{"SetShortcuts", []string{"[]*gui.QKeySequence"}},
+			{"SetShortcuts2", []string{"gui.QKeySequence__StandardKey"}},
+		},
+	},
+	"QActionGroup": {
+		"QActionGroup": {
+			{"NewQActionGroup", []string{"*core.QObject"}},
+		},
+		"addAction": {
+			{"AddAction", []string{"*widgets.QAction"}},
+			{"AddAction2", []string{"string"}},
+			{"AddAction3", []string{"*gui.QIcon", "string"}},
+		},
+	},
+	"QApplication": {
+		"setStyle": {
+			{"SetStyle", []string{"*widgets.QStyle"}},
+			{"SetStyle2", []string{"string"}},
+		},
+	},
+	"QBitArray": {
+		"QBitArray": {
+			{"NewQBitArray", []string{}},
+			{"NewQBitArray2", []string{"int", "bool"}},
+			{"NewQBitArray3", []string{"*core.QBitArray"}},
+			{"NewQBitArray4", []string{"*core.QBitArray"}},
+		},
+		"setBit": {
+			{"SetBit", []string{"int"}},
+			{"SetBit2", []string{"int", "bool"}},
+		},
+	},
+	"QBitmap": {
+		"QBitmap": {
+			{"NewQBitmap", []string{}},
+			{"NewQBitmap2", []string{"*gui.QPixmap"}},
+			{"NewQBitmap3", []string{"int", "int"}},
+			{"NewQBitmap4", []string{"*core.QSize"}},
+			{"NewQBitmap5", []string{"string", "string"}},
+		},
+	},
+	"QBoxLayout": {
+		"QBoxLayout": {
+			{"NewQBoxLayout", []string{"widgets.QBoxLayout__Direction", "*widgets.QWidget"}},
+		},
+		"setStretchFactor": {
+			{"SetStretchFactor", []string{"*widgets.QWidget", "int"}},
+			{"SetStretchFactor2", []string{"*widgets.QLayout", "int"}},
+		},
+	},
+	"QBrush": {
+		"QBrush": {
+			{"NewQBrush", []string{}},
+			{"NewQBrush2", []string{"core.Qt__BrushStyle"}},
+			{"NewQBrush3", []string{"*gui.QColor", "core.Qt__BrushStyle"}},
+			{"NewQBrush4", []string{"core.Qt__GlobalColor", "core.Qt__BrushStyle"}},
+			{"NewQBrush5", []string{"*gui.QColor", "*gui.QPixmap"}},
+			{"NewQBrush6", []string{"core.Qt__GlobalColor", "*gui.QPixmap"}},
+			{"NewQBrush7", []string{"*gui.QPixmap"}},
+			{"NewQBrush8", []string{"*gui.QImage"}},
+			{"NewQBrush9", []string{"*gui.QBrush"}},
+			{"NewQBrush10", []string{"*gui.QGradient"}},
+		},
+		"setColor": {
+			{"SetColor", []string{"*gui.QColor"}},
+			{"SetColor2", []string{"core.Qt__GlobalColor"}},
+		},
+	},
+	"QBuffer": {
+		"QBuffer": {
+			{"NewQBuffer", []string{"*core.QObject"}},
+			{"NewQBuffer2", []string{"*core.QByteArray", "*core.QObject"}},
+		},
+		"setData": {
+			{"SetData", []string{"*core.QByteArray"}},
+			{"SetData2", []string{"[]byte", "int"}},
+		},
+	},
+	"QButtonGroup": {
+		"QButtonGroup": {
+			{"NewQButtonGroup", []string{"*core.QObject"}},
+		},
+	},
+	"QByteArray": {
+		"QByteArray": {
+			{"NewQByteArray", []string{}},
+			{"NewQByteArray2", []string{"string", "int"}},
+			{"NewQByteArray3", []string{"int", "string"}},
+			{"NewQByteArray4", []string{"*core.QByteArray"}},
+		},
+		"insert": {
+			{"Insert", []string{"int", "*core.QByteArray"}},
+			{"Insert2", []string{"int", "string"}},
+			{"Insert3", []string{"int", "int", "string"}},
+			{"Insert4", []string{"int", "string"}},
+			{"Insert5", []string{"int", "string", "int"}},
+			{"Insert6", []string{"int", "string"}},
+		},
+		"setNum": {
+			{"SetNum", []string{"int", "int"}},
+			{"SetNum2", []string{"int16", "int"}},
+			{"SetNum3", []string{"uint16", "int"}},
+			{"SetNum4", []string{"uint", "int"}},
+			{"SetNum5", []string{"int64", "int"}},
+			{"SetNum6", []string{"uint64", "int"}},
+			{"SetNum7", []string{"float32", "string", "int"}},
+			{"SetNum8", []string{"float64", "string", "int"}},
+		},
+	},
+	"QByteArrayMatcher": {
+		"QByteArrayMatcher": {
+			{"NewQByteArrayMatcher", []string{}},
+			{"NewQByteArrayMatcher2", []string{"*core.QByteArray"}},
+			{"NewQByteArrayMatcher3", []string{"string", "int"}},
+			{"NewQByteArrayMatcher4", []string{"*core.QByteArrayMatcher"}},
+		},
+	},
+	"QCalendarWidget": {
+		"QCalendarWidget": {
+			{"NewQCalendarWidget", []string{"*widgets.QWidget"}},
+		},
+	},
+	"QCborArray": {
+		"QCborArray": {
+			{"NewQCborArray", []string{}},
+			{"NewQCborArray2", []string{"*core.QCborArray"}},
+		},
+	},
+	"QCborMap": {
+		"QCborMap": {
+			{"NewQCborMap", []string{}},
+			{"NewQCborMap2", []string{"*core.QCborMap"}},
+		},
+	},
+	"QChar": {
+		"QChar": {
+			{"NewQChar", []string{}},
+			{"NewQChar2", []string{"uint16"}},
+			{"NewQChar3", []string{"string", "string"}},
+			{"NewQChar4", []string{"int16"}},
+			{"NewQChar5", []string{"uint"}},
+			{"NewQChar6", []string{"int"}},
+			{"NewQChar7", []string{"core.QChar__SpecialCharacter"}},
+			{"NewQChar8", []string{"*core.QLatin1Char"}},
+			{"NewQChar11", []string{"string"}},
+			{"NewQChar12", []string{"string"}},
+		},
+	},
+	"QCheckBox": {
+		"QCheckBox": {
+			{"NewQCheckBox", []string{"*widgets.QWidget"}},
+			{"NewQCheckBox2", []string{"string", "*widgets.QWidget"}},
+		},
+	},
+	"QCollator": {
+		"QCollator": {
+			{"NewQCollator", []string{"*core.QLocale"}},
+			{"NewQCollator2", []string{"*core.QCollator"}},
+			{"NewQCollator3", []string{"*core.QCollator"}},
+		},
+	},
+	"QColor": {
+		"QColor": {
+			{"NewQColor", []string{}},
+			{"NewQColor2", []string{"core.Qt__GlobalColor"}},
+			{"NewQColor3", []string{"int", "int", "int", "int"}},
+			{"NewQColor4", []string{"uint"}},
+			{"NewQColor5", []string{"*gui.QRgba64"}},
+			{"NewQColor6", []string{"string"}},
+			{"NewQColor8", []string{"string"}},
+			{"NewQColor9", []string{"*core.QLatin1String"}},
+		},
+		"setNamedColor": {
+			{"SetNamedColor", []string{"string"}},
+			{"SetNamedColor2", []string{"*core.QStringView"}},
+			{"SetNamedColor3", []string{"*core.QLatin1String"}},
+		},
+		"setRgb": {
+			{"SetRgb", []string{"int", "int", "int", "int"}},
+			{"SetRgb2", []string{"uint"}},
+		},
+		"setRgba": {
+			{"SetRgba", []string{"uint"}},
+			{"SetRgba64", []string{"*gui.QRgba64"}},
+		},
+	},
+	"QColorDialog": {
+		"QColorDialog": {
+			{"NewQColorDialog", []string{"*widgets.QWidget"}},
+			{"NewQColorDialog2", []string{"*gui.QColor", "*widgets.QWidget"}},
+		},
+	},
+	"QColumnView": {
+		"QColumnView": {
+			{"NewQColumnView", []string{"*widgets.QWidget"}},
+		},
+	},
+	"QComboBox": {
+		"QComboBox": {
+			{"NewQComboBox", []string{"*widgets.QWidget"}},
+		},
+		"addItem": {
+			{"AddItem", []string{"string", "*core.QVariant"}},
+			{"AddItem2", []string{"*gui.QIcon", "string", "*core.QVariant"}},
+		},
+		"insertItem": {
+			{"InsertItem", []string{"int", "string", "*core.QVariant"}},
+			{"InsertItem2", []string{"int", "*gui.QIcon", "string", "*core.QVariant"}},
+		},
+	},
+	"QCommandLineOption": {
+		"QCommandLineOption": {
+			{"NewQCommandLineOption", []string{"string"}},
+			{"NewQCommandLineOption2", []string{"[]string"}},
+			{"NewQCommandLineOption3", []string{"string", "string", "string", "string"}},
+			{"NewQCommandLineOption4", []string{"[]string", "string", "string", "string"}},
+			{"NewQCommandLineOption5", []string{"*core.QCommandLineOption"}},
+		},
+	},
+	"QCommandLinkButton": {
+		"QCommandLinkButton": {
+			{"NewQCommandLinkButton", []string{"*widgets.QWidget"}},
+			{"NewQCommandLinkButton2", []string{"string", "*widgets.QWidget"}},
+			{"NewQCommandLinkButton3", []string{"string", "string", "*widgets.QWidget"}},
+		},
+	},
+	"QCompleter": {
+		"QCompleter": {
+			{"NewQCompleter", []string{"*core.QObject"}},
+			{"NewQCompleter2", []string{"*core.QAbstractItemModel", "*core.QObject"}},
+			{"NewQCompleter3", []string{"[]string", "*core.QObject"}},
+		},
+	},
+	"QConicalGradient": {
+		"QConicalGradient": {
+			{"NewQConicalGradient", []string{}},
+			{"NewQConicalGradient2", []string{"*core.QPointF", "float64"}},
+			{"NewQConicalGradient3", []string{"float64", "float64", "float64"}},
+		},
+		"setCenter": {
+			{"SetCenter", []string{"*core.QPointF"}},
+			{"SetCenter2", []string{"float64", "float64"}},
+		},
+	},
+	"QContextMenuEvent": {
+		"QContextMenuEvent": {
+			{"NewQContextMenuEvent", []string{"gui.QContextMenuEvent__Reason", "*core.QPoint", "*core.QPoint", "core.Qt__KeyboardModifier"}},
+			{"NewQContextMenuEvent2", []string{"gui.QContextMenuEvent__Reason", "*core.QPoint", "*core.QPoint"}},
+			{"NewQContextMenuEvent3", []string{"gui.QContextMenuEvent__Reason", "*core.QPoint"}},
+		},
+	},
+	"QCryptographicHash": {
+		"addData": {
+			{"AddData", []string{"[]byte", "int"}},
+			{"AddData2", []string{"*core.QByteArray"}},
+			{"AddData3", []string{"*core.QIODevice"}},
+		},
+	},
+	"QCursor": {
+		"QCursor": {
+			{"NewQCursor", []string{}},
+			{"NewQCursor2", []string{"core.Qt__CursorShape"}},
+			{"NewQCursor3", []string{"*gui.QBitmap", "*gui.QBitmap", "int", "int"}},
+			{"NewQCursor4", []string{"*gui.QPixmap", "int", "int"}},
+			{"NewQCursor5", []string{"*gui.QCursor"}},
+			{"NewQCursor6", []string{"*gui.QCursor"}},
+		},
+		"setPos": {
+			{"SetPos", []string{"int", "int"}},
+			{"SetPos2", []string{"*gui.QScreen", "int", "int"}},
+			{"SetPos3", []string{"*core.QPoint"}},
+			{"SetPos4", []string{"*gui.QScreen", "*core.QPoint"}},
+		},
+	},
+	"QDataStream": {
+		"QDataStream": {
+			{"NewQDataStream", []string{}},
+			{"NewQDataStream2", []string{"*core.QIODevice"}},
+			{"NewQDataStream3", []string{"*core.QByteArray", "core.QIODevice__OpenModeFlag"}},
+			{"NewQDataStream4", []string{"*core.QByteArray"}},
+		},
+	},
+	"QDataWidgetMapper": {
+		"addMapping": {
+			{"AddMapping", []string{"*widgets.QWidget", "int"}},
+			{"AddMapping2", []string{"*widgets.QWidget", "int", "*core.QByteArray"}},
+		},
+	},
+	"QDate": {
+		"QDate": {
+			{"NewQDate2", []string{}},
+			{"NewQDate3", []string{"int", "int", "int"}},
+		},
+	},
+	"QDateEdit": {
+		"QDateEdit": {
+			{"NewQDateEdit", []string{"*widgets.QWidget"}},
+			{"NewQDateEdit2", []string{"*core.QDate", "*widgets.QWidget"}},
+		},
+	},
+	"QDateTime": {
+		"QDateTime": {
+			{"NewQDateTime", []string{}},
+			{"NewQDateTime2", []string{"*core.QDate"}},
+			{"NewQDateTime3", []string{"*core.QDate", "*core.QTime", "core.Qt__TimeSpec"}},
+			{"NewQDateTime4", []string{"*core.QDate", "*core.QTime", "core.Qt__TimeSpec", "int"}},
+			{"NewQDateTime5", []string{"*core.QDate", "*core.QTime", "*core.QTimeZone"}},
+			{"NewQDateTime6", []string{"*core.QDateTime"}},
+			{"NewQDateTime7", []string{"*core.QDateTime"}},
+		},
+	},
+	"QDateTimeEdit": {
+		"QDateTimeEdit": {
+			{"NewQDateTimeEdit", []string{"*widgets.QWidget"}},
+			{"NewQDateTimeEdit2", []string{"*core.QDateTime", "*widgets.QWidget"}},
+			{"NewQDateTimeEdit3", []string{"*core.QDate", "*widgets.QWidget"}},
+			{"NewQDateTimeEdit4", []string{"*core.QTime", "*widgets.QWidget"}},
+		},
+	},
+	"QDeadlineTimer": {
+		"QDeadlineTimer": {
+			{"NewQDeadlineTimer", []string{"core.Qt__TimerType"}},
+			{"NewQDeadlineTimer2", []string{"core.QDeadlineTimer__ForeverConstant", "core.Qt__TimerType"}},
+			{"NewQDeadlineTimer3", []string{"int64", "core.Qt__TimerType"}},
+		},
+	},
+	"QDebug": {
+		"QDebug": {
+			{"NewQDebug", []string{"*core.QIODevice"}},
+			{"NewQDebug2", []string{"string"}},
+			{"NewQDebug4", []string{"*core.QDebug"}},
+		},
+	},
+	"QDesktopWidget": {
+		"QDesktopWidget": {
+			{"NewQDesktopWidget", []string{}},
+		},
+	},
+	"QDial": {
+		"QDial": {
+			{"NewQDial", []string{"*widgets.QWidget"}},
+		},
+	},
+	"QDialog": {
+		"QDialog": {
+			{"NewQDialog", []string{"*widgets.QWidget", "core.Qt__WindowType"}},
+		},
+	},
+	"QDialogButtonBox": {
+		"QDialogButtonBox": {
+			{"NewQDialogButtonBox", []string{"*widgets.QWidget"}},
+			{"NewQDialogButtonBox2", []string{"core.Qt__Orientation", "*widgets.QWidget"}},
+			{"NewQDialogButtonBox3", []string{"widgets.QDialogButtonBox__StandardButton", "*widgets.QWidget"}},
+			{"NewQDialogButtonBox4", []string{"widgets.QDialogButtonBox__StandardButton", "core.Qt__Orientation", "*widgets.QWidget"}},
+		},
+		"addButton": {
+			{"AddButton", []string{"*widgets.QAbstractButton", "widgets.QDialogButtonBox__ButtonRole"}},
+			{"AddButton2", []string{"string", "widgets.QDialogButtonBox__ButtonRole"}},
+			{"AddButton3", []string{"widgets.QDialogButtonBox__StandardButton"}},
+		},
+	},
+	"QDir": {
+		"QDir": {
+			{"NewQDir", []string{"*core.QDir"}},
+			{"NewQDir2", []string{"string"}},
+			{"NewQDir3", []string{"string", "string", "core.QDir__SortFlag", "core.QDir__Filter"}},
+		},
+	},
+	"QDirModel": {
+		"QDirModel": {
+			{"NewQDirModel", []string{"[]string", "core.QDir__Filter", "core.QDir__SortFlag", "*core.QObject"}},
+			{"NewQDirModel2", []string{"*core.QObject"}},
+		},
+	},
+	"QDockWidget": {
+		"QDockWidget": {
+			{"NewQDockWidget", []string{"string", "*widgets.QWidget", "core.Qt__WindowType"}},
+			{"NewQDockWidget2", []string{"*widgets.QWidget", "core.Qt__WindowType"}},
+		},
+	},
+	"QDoubleSpinBox": {
+		"QDoubleSpinBox": {
+			{"NewQDoubleSpinBox", []string{"*widgets.QWidget"}},
+		},
+	},
+	"QDoubleValidator": {
+		"QDoubleValidator": {
+			{"NewQDoubleValidator", []string{"*core.QObject"}},
+			{"NewQDoubleValidator2", []string{"float64", "float64", "int", "*core.QObject"}},
+		},
+	},
+	"QEasingCurve": {
+		"QEasingCurve": {
+			{"NewQEasingCurve", []string{"core.QEasingCurve__Type"}},
+			{"NewQEasingCurve2", []string{"*core.QEasingCurve"}},
+			{"NewQEasingCurve3", []string{"*core.QEasingCurve"}},
+		},
+	},
+	"QErrorMessage": {
+		"QErrorMessage": {
+			{"NewQErrorMessage", []string{"*widgets.QWidget"}},
+		},
+	},
+	"QEventLoopLocker": {
+		"QEventLoopLocker": {
+			{"NewQEventLoopLocker", []string{}},
+			{"NewQEventLoopLocker2", []string{"*core.QEventLoop"}},
+			{"NewQEventLoopLocker3", []string{"*core.QThread"}},
+		},
+	},
+	"QEventTransition": {
+		"QEventTransition": {
+			{"NewQEventTransition", []string{"*core.QState"}},
+			{"NewQEventTransition2", []string{"*core.QObject", "core.QEvent__Type", "*core.QState"}},
+		},
+	},
+	"QFile": {
+		"QFile": {
+			{"NewQFile", []string{}},
+			{"NewQFile2", []string{"string"}},
+			{"NewQFile3", []string{"*core.QObject"}},
+			{"NewQFile4", []string{"string", "*core.QObject"}},
+		},
+	},
+	"QFileDialog": {
+		"QFileDialog": {
+			{"NewQFileDialog", []string{"*widgets.QWidget", "core.Qt__WindowType"}},
+			{"NewQFileDialog2", []string{"*widgets.QWidget", "string", "string", "string"}},
+		},
+		"setDirectory": {
+			{"SetDirectory", []string{"string"}},
+			{"SetDirectory2", []string{"*core.QDir"}},
+		},
+	},
+	"QFileInfo": {
+		"QFileInfo": {
+			{"NewQFileInfo2", []string{}},
+			{"NewQFileInfo3", []string{"string"}},
+			{"NewQFileInfo4", []string{"*core.QFile"}},
+			{"NewQFileInfo5", []string{"*core.QDir", "string"}},
+			{"NewQFileInfo6", []string{"*core.QFileInfo"}},
+		},
+		"setFile": {
+			{"SetFile", []string{"string"}},
+			{"SetFile2", []string{"*core.QFile"}},
+			{"SetFile3", []string{"*core.QDir", "string"}},
+		},
+	},
+	"QFileSystemWatcher": {
+		"QFileSystemWatcher": {
+			{"NewQFileSystemWatcher", []string{"*core.QObject"}},
+			{"NewQFileSystemWatcher2", []string{"[]string", "*core.QObject"}},
+		},
+	},
+	"QFlag": {
+		"QFlag": {
+			{"NewQFlag", []string{"int"}},
+			{"NewQFlag2", []string{"uint"}},
+			{"NewQFlag3", []string{"int16"}},
+			{"NewQFlag4", []string{"uint16"}},
+		},
+	},
+	"QFocusFrame": {
+		"QFocusFrame": {
+			{"NewQFocusFrame", []string{"*widgets.QWidget"}},
+		},
+	},
+	"QFont": {
+		"QFont": {
+			{"NewQFont", []string{}},
+			{"NewQFont2", []string{"string", "int", "int", "bool"}},
+			{"NewQFont4", []string{"*gui.QFont", "*gui.QPaintDevice"}},
+			{"NewQFont5", []string{"*gui.QFont"}},
+		},
+	},
+	"QFontComboBox": {
+		"QFontComboBox": {
+			{"NewQFontComboBox", []string{"*widgets.QWidget"}},
+		},
+	},
+	"QFontDialog": {
+		"QFontDialog": {
+			{"NewQFontDialog", []string{"*widgets.QWidget"}},
+			{"NewQFontDialog2", []string{"*gui.QFont", "*widgets.QWidget"}},
+		},
+	},
+	"QFontInfo": {
+		"QFontInfo": {
+			{"NewQFontInfo", []string{"*gui.QFont"}},
+			{"NewQFontInfo2", []string{"*gui.QFontInfo"}},
+		},
+	},
+	"QFontMetrics": {
+		"QFontMetrics": {
+			{"NewQFontMetrics", []string{"*gui.QFont"}},
+			{"NewQFontMetrics3", []string{"*gui.QFont", "*gui.QPaintDevice"}},
+			{"NewQFontMetrics4", []string{"*gui.QFontMetrics"}},
+		},
+	},
+	"QFontMetricsF": {
+		"QFontMetricsF": {
+			{"NewQFontMetricsF", []string{"*gui.QFont"}},
+			{"NewQFontMetricsF3", []string{"*gui.QFont", "*gui.QPaintDevice"}},
+			{"NewQFontMetricsF4", []string{"*gui.QFontMetrics"}},
+			{"NewQFontMetricsF5", []string{"*gui.QFontMetricsF"}},
+		},
+	},
+	"QFormLayout": {
+		"QFormLayout": {
+			{"NewQFormLayout", []string{"*widgets.QWidget"}},
+		},
+		"addRow": {
+			{"AddRow", []string{"*widgets.QWidget", "*widgets.QWidget"}},
+			{"AddRow2", []string{"*widgets.QWidget", "*widgets.QLayout"}},
+			{"AddRow3", []string{"string", "*widgets.QWidget"}},
+			{"AddRow4", []string{"string", "*widgets.QLayout"}},
+			{"AddRow5", []string{"*widgets.QWidget"}},
+			{"AddRow6", []string{"*widgets.QLayout"}},
+		},
+		"insertRow": {
+			{"InsertRow", []string{"int", "*widgets.QWidget", "*widgets.QWidget"}},
+			{"InsertRow2", []string{"int", "*widgets.QWidget", "*widgets.QLayout"}},
+			{"InsertRow3", []string{"int", "string", "*widgets.QWidget"}},
+			{"InsertRow4", []string{"int", "string", "*widgets.QLayout"}},
+			{"InsertRow5", []string{"int", "*widgets.QWidget"}},
+			{"InsertRow6", []string{"int", "*widgets.QLayout"}},
+		},
+	},
+	"QFrame": {
+		"QFrame": {
+			{"NewQFrame", []string{"*widgets.QWidget", "core.Qt__WindowType"}},
+		},
+	},
+	"QGestureEvent": {
+		"setAccepted": {
+			{"SetAccepted", []string{"*widgets.QGesture", "bool"}},
+			{"SetAccepted2", []string{"core.Qt__GestureType", "bool"}},
+		},
+	},
+	"QGlyphRun": {
+		"QGlyphRun": {
+			{"NewQGlyphRun", []string{}},
+			{"NewQGlyphRun2", []string{"*gui.QGlyphRun"}},
+		},
+	},
+	"QGraphicsDropShadowEffect": {
+		"setOffset": {
+			{"SetOffset", []string{"*core.QPointF"}},
+			{"SetOffset2", []string{"float64", "float64"}},
+			{"SetOffset3", []string{"float64"}},
+		},
+	},
+	"QGraphicsEllipseItem": {
+		"QGraphicsEllipseItem": {
+			{"NewQGraphicsEllipseItem", []string{"*widgets.QGraphicsItem"}},
+			{"NewQGraphicsEllipseItem2", []string{"*core.QRectF", "*widgets.QGraphicsItem"}},
+			{"NewQGraphicsEllipseItem3", []string{"float64", "float64", "float64", "float64", "*widgets.QGraphicsItem"}},
+		},
+		"setRect": {
+			{"SetRect", []string{"*core.QRectF"}},
+			{"SetRect2", []string{"float64", "float64", "float64", "float64"}},
+		},
+	},
+	"QGraphicsGridLayout": {
+		"addItem": {
+			{"AddItem", []string{"*widgets.QGraphicsLayoutItem", "int", "int", "int", "int", "core.Qt__AlignmentFlag"}},
+			{"AddItem2", []string{"*widgets.QGraphicsLayoutItem", "int", "int", "core.Qt__AlignmentFlag"}},
+		},
+	},
+	"QGraphicsItem": {
+		"setPos": {
+			{"SetPos", []string{"*core.QPointF"}},
+			{"SetPos2", []string{"float64", "float64"}},
+		},
+		"setTransformOriginPoint": {
+			{"SetTransformOriginPoint", []string{"*core.QPointF"}},
+			{"SetTransformOriginPoint2", []string{"float64", "float64"}},
+		},
+	},
+	"QGraphicsLayoutItem": {
+		"setMaximumSize": {
+			{"SetMaximumSize", []string{"*core.QSizeF"}},
+			{"SetMaximumSize2", []string{"float64", "float64"}},
+		},
+		"setMinimumSize": {
+			{"SetMinimumSize", []string{"*core.QSizeF"}},
+			{"SetMinimumSize2", []string{"float64", "float64"}},
+		},
+		"setPreferredSize": {
+			{"SetPreferredSize", []string{"*core.QSizeF"}},
+			{"SetPreferredSize2", []string{"float64", "float64"}},
+		},
+		"setSizePolicy": {
+			{"SetSizePolicy", []string{"*widgets.QSizePolicy"}},
+			{"SetSizePolicy2", []string{"widgets.QSizePolicy__Policy", "widgets.QSizePolicy__Policy", "widgets.QSizePolicy__ControlType"}},
+		},
+	},
+	"QGraphicsLineItem": {
+		"QGraphicsLineItem": {
+			{"NewQGraphicsLineItem", []string{"*widgets.QGraphicsItem"}},
+			{"NewQGraphicsLineItem2", []string{"*core.QLineF", "*widgets.QGraphicsItem"}},
+			{"NewQGraphicsLineItem3", []string{"float64", "float64", "float64", "float64", "*widgets.QGraphicsItem"}},
+		},
+		"setLine": {
+			{"SetLine", []string{"*core.QLineF"}},
+			{"SetLine2", []string{"float64", "float64", "float64", "float64"}},
+		},
+	},
+	"QGraphicsLinearLayout": {
+		"QGraphicsLinearLayout": {
+			{"NewQGraphicsLinearLayout", []string{"*widgets.QGraphicsLayoutItem"}},
+			{"NewQGraphicsLinearLayout2", []string{"core.Qt__Orientation", "*widgets.QGraphicsLayoutItem"}},
+		},
+	},
+	"QGraphicsPathItem": {
+		"QGraphicsPathItem": {
+			{"NewQGraphicsPathItem", []string{"*widgets.QGraphicsItem"}},
+			{"NewQGraphicsPathItem2", []string{"*gui.QPainterPath", "*widgets.QGraphicsItem"}},
+		},
+	},
+	"QGraphicsPixmapItem": {
+		"QGraphicsPixmapItem": {
+			{"NewQGraphicsPixmapItem", []string{"*widgets.QGraphicsItem"}},
+			{"NewQGraphicsPixmapItem2", []string{"*gui.QPixmap", "*widgets.QGraphicsItem"}},
+		},
+		"setOffset": {
+			{"SetOffset", []string{"*core.QPointF"}},
+			{"SetOffset2", []string{"float64", "float64"}},
+		},
+	},
+	"QGraphicsPolygonItem": {
+		"QGraphicsPolygonItem": {
+			{"NewQGraphicsPolygonItem", []string{"*widgets.QGraphicsItem"}},
+			{"NewQGraphicsPolygonItem2", []string{"*gui.QPolygonF", "*widgets.QGraphicsItem"}},
+		},
+	},
+	"QGraphicsRectItem": {
+		"QGraphicsRectItem": {
+			{"NewQGraphicsRectItem", []string{"*widgets.QGraphicsItem"}},
+			{"NewQGraphicsRectItem2", []string{"*core.QRectF", "*widgets.QGraphicsItem"}},
+			{"NewQGraphicsRectItem3", []string{"float64", "float64", "float64", "float64", "*widgets.QGraphicsItem"}},
+		},
+		"setRect": {
+			{"SetRect", []string{"*core.QRectF"}},
+			{"SetRect2", []string{"float64", "float64", "float64", "float64"}},
+		},
+	},
+	"QGraphicsRotation": {
+		"setAxis": {
+			{"SetAxis", []string{"*gui.QVector3D"}},
+			{"SetAxis2", []string{"core.Qt__Axis"}},
+		},
+	},
+	"QGraphicsScene": {
+		"QGraphicsScene": {
+			{"NewQGraphicsScene", []string{"*core.QObject"}},
+			{"NewQGraphicsScene2", []string{"*core.QRectF", "*core.QObject"}},
+			{"NewQGraphicsScene3", []string{"float64", "float64", "float64", "float64", "*core.QObject"}},
+		},
+		"addEllipse": {
+			{"AddEllipse", []string{"*core.QRectF", "*gui.QPen", "*gui.QBrush"}},
+			{"AddEllipse2", []string{"float64", "float64", "float64", "float64", "*gui.QPen", "*gui.QBrush"}},
+		},
+		"addLine": {
+			{"AddLine", []string{"*core.QLineF", "*gui.QPen"}},
+			{"AddLine2", []string{"float64", "float64", "float64", "float64", "*gui.QPen"}},
+		},
+		"addRect": {
+			{"AddRect", []string{"*core.QRectF", "*gui.QPen", "*gui.QBrush"}},
+			{"AddRect2", []string{"float64", "float64", "float64", "float64", "*gui.QPen", "*gui.QBrush"}},
+		},
+		"setSceneRect": {
+			{"SetSceneRect", []string{"*core.QRectF"}},
+			{"SetSceneRect2", []string{"float64", "float64", "float64", "float64"}},
+		},
+		"setSelectionArea": {
+			{"SetSelectionArea", []string{"*gui.QPainterPath", "*gui.QTransform"}},
+			{"SetSelectionArea2", []string{"*gui.QPainterPath", "core.Qt__ItemSelectionMode", "*gui.QTransform"}},
+			{"SetSelectionArea3", []string{"*gui.QPainterPath", "core.Qt__ItemSelectionOperation", "core.Qt__ItemSelectionMode", "*gui.QTransform"}},
+		},
+	},
+	"QGraphicsSimpleTextItem": {
+		"QGraphicsSimpleTextItem": {
+			{"NewQGraphicsSimpleTextItem", []string{"*widgets.QGraphicsItem"}},
+			{"NewQGraphicsSimpleTextItem2", []string{"string", "*widgets.QGraphicsItem"}},
+		},
+	},
+	"QGraphicsTextItem": {
+		"QGraphicsTextItem": {
+			{"NewQGraphicsTextItem", []string{"*widgets.QGraphicsItem"}},
+			{"NewQGraphicsTextItem2", []string{"string", "*widgets.QGraphicsItem"}},
+		},
+	},
+	"QGraphicsView": {
+		"QGraphicsView": {
+			{"NewQGraphicsView", []string{"*widgets.QWidget"}},
+			{"NewQGraphicsView2", []string{"*widgets.QGraphicsScene", "*widgets.QWidget"}},
+		},
+		"setSceneRect": {
+			{"SetSceneRect", []string{"*core.QRectF"}},
+			{"SetSceneRect2", []string{"float64", "float64", "float64", "float64"}},
+		},
+	},
+	"QGridLayout": {
+		"QGridLayout": {
+			{"NewQGridLayout", []string{"*widgets.QWidget"}},
+			{"NewQGridLayout2", []string{}},
+		},
+		"addItem": {
+			{"AddItem", []string{"*widgets.QLayoutItem", "int", "int", "int", "int", "core.Qt__AlignmentFlag"}},
+			{"AddItem2", []string{"*widgets.QLayoutItem"}},
+		},
+		"addLayout": {
+			{"AddLayout", []string{"*widgets.QLayout", "int", "int", "core.Qt__AlignmentFlag"}},
+			{"AddLayout2", []string{"*widgets.QLayout", "int", "int", "int", "int", "core.Qt__AlignmentFlag"}},
+		},
+		"addWidget": {
+			{"AddWidget2", []string{"*widgets.QWidget", "int", "int", "core.Qt__AlignmentFlag"}},
+			{"AddWidget3", []string{"*widgets.QWidget", "int", "int", "int", "int", "core.Qt__AlignmentFlag"}},
+		},
+	},
+	"QGroupBox": {
+		"QGroupBox": {
+			{"NewQGroupBox", []string{"*widgets.QWidget"}},
+			{"NewQGroupBox2", []string{"string", "*widgets.QWidget"}},
+		},
+	},
+	"QHBoxLayout": {
+		"QHBoxLayout": {
+			{"NewQHBoxLayout", []string{}},
+			{"NewQHBoxLayout2", []string{"*widgets.QWidget"}},
+		},
+	},
+	"QHeaderView": {
+		"QHeaderView": {
+			{"NewQHeaderView", []string{"core.Qt__Orientation", "*widgets.QWidget"}},
+		},
+		"setSectionResizeMode": {
+			{"SetSectionResizeMode", []string{"widgets.QHeaderView__ResizeMode"}},
+			{"SetSectionResizeMode2", []string{"int", "widgets.QHeaderView__ResizeMode"}},
+		},
+	},
+	"QHistoryState": {
+		"QHistoryState": {
+			{"NewQHistoryState", []string{"*core.QState"}},
+			{"NewQHistoryState2", []string{"core.QHistoryState__HistoryType", "*core.QState"}},
+		},
+	},
+	"QIODevice": {
+		"QIODevice": {
+			{"NewQIODevice", []string{}},
+			{"NewQIODevice2", []string{"*core.QObject"}},
+		},
+	},
+	"QIcon": {
+		"QIcon": {
+			{"NewQIcon", []string{}},
+			{"NewQIcon2", []string{"*gui.QPixmap"}},
+			{"NewQIcon3", []string{"*gui.QIcon"}},
+			{"NewQIcon4", []string{"*gui.QIcon"}},
+			{"NewQIcon5", []string{"string"}},
+			{"NewQIcon6", []string{"*gui.QIconEngine"}},
+		},
+	},
+	"QImage": {
+		"QImage": {
+			{"NewQImage", []string{}},
+			{"NewQImage2", []string{"*core.QSize", "gui.QImage__Format"}},
+			{"NewQImage3", []string{"int", "int", "gui.QImage__Format"}},
+			{"NewQImage4", []string{"string", "int", "int", "gui.QImage__Format"}},
+			{"NewQImage5", []string{"string", "int", "int", "gui.QImage__Format"}},
+			{"NewQImage6", []string{"string", "int", "int", "int", "gui.QImage__Format"}},
+			{"NewQImage7", []string{"string", "int", "int", "int", "gui.QImage__Format"}},
+			{"NewQImage9", []string{"string", "string"}},
+			{"NewQImage10", []string{"*gui.QImage"}},
+			{"NewQImage11", []string{"*gui.QImage"}},
+		},
+		"setPixel": {
+			{"SetPixel", []string{"*core.QPoint", "uint"}},
+			{"SetPixel2", []string{"int", "int", "uint"}},
+		},
+		"setPixelColor": {
+			{"SetPixelColor", []string{"*core.QPoint", "*gui.QColor"}},
+			{"SetPixelColor2", []string{"int", "int", "*gui.QColor"}},
+		},
+	},
+	"QImageIOHandler": {
+		"setFormat": {
+			{"SetFormat", []string{"*core.QByteArray"}},
+			{"SetFormat2", []string{"*core.QByteArray"}},
+		},
+	},
+	"QImageReader": {
+		"QImageReader": {
+			{"NewQImageReader", []string{}},
+			{"NewQImageReader2", []string{"*core.QIODevice", "*core.QByteArray"}},
+			{"NewQImageReader3", []string{"string", "*core.QByteArray"}},
+		},
+	},
+	"QImageWriter": {
+		"QImageWriter": {
+			{"NewQImageWriter", []string{}},
+			{"NewQImageWriter2", []string{"*core.QIODevice", "*core.QByteArray"}},
+			{"NewQImageWriter3", []string{"string", "*core.QByteArray"}},
+		},
+	},
+	"QInputDialog": {
+		"QInputDialog": {
+			{"NewQInputDialog", []string{"*widgets.QWidget", "core.Qt__WindowType"}},
+		},
+	},
+	"QInputMethodEvent": {
+		"QInputMethodEvent": {
+			{"NewQInputMethodEvent", []string{}},
+			{"NewQInputMethodEvent3", []string{"*gui.QInputMethodEvent"}},
+		},
+	},
+	"QIntValidator": {
+		"QIntValidator": {
+			{"NewQIntValidator", []string{"*core.QObject"}},
+			{"NewQIntValidator2", []string{"int", "int", "*core.QObject"}},
+		},
+	},
+	"QItemSelection": {
+		"QItemSelection": {
+			{"NewQItemSelection", []string{}},
+			{"NewQItemSelection2", []string{"*core.QModelIndex", "*core.QModelIndex"}},
+		},
+	},
+	"QItemSelectionModel": {
+		"QItemSelectionModel": {
+			{"NewQItemSelectionModel", []string{"*core.QAbstractItemModel"}},
+			{"NewQItemSelectionModel2", []string{"*core.QAbstractItemModel", "*core.QObject"}},
+		},
+	},
+	"QItemSelectionRange": {
+		"QItemSelectionRange": {
+			{"NewQItemSelectionRange", []string{}},
+			{"NewQItemSelectionRange2", []string{"*core.QItemSelectionRange"}},
+			{"NewQItemSelectionRange4", []string{"*core.QModelIndex", "*core.QModelIndex"}},
+			{"NewQItemSelectionRange5", []string{"*core.QModelIndex"}},
+		},
+	},
+	"QJsonArray": {
+		"QJsonArray": {
+			{"NewQJsonArray", []string{}},
+			{"NewQJsonArray3", []string{"*core.QJsonArray"}},
+			{"NewQJsonArray4", []string{"*core.QJsonArray"}},
+		},
+	},
+	"QJsonDocument": {
+		"QJsonDocument": {
+			{"NewQJsonDocument", []string{}},
+			{"NewQJsonDocument2", []string{"*core.QJsonObject"}},
+			{"NewQJsonDocument3", []string{"*core.QJsonArray"}},
+			{"NewQJsonDocument4", []string{"*core.QJsonDocument"}},
+			{"NewQJsonDocument5", []string{"*core.QJsonDocument"}},
+		},
+	},
+	"QJsonObject": {
+		"QJsonObject": {
+			{"NewQJsonObject", []string{}},
+			{"NewQJsonObject3", []string{"*core.QJsonObject"}},
+			{"NewQJsonObject4", []string{"*core.QJsonObject"}},
+		},
+	},
+	"QJsonValue": {
+		"QJsonValue": {
+			{"NewQJsonValue", []string{"core.QJsonValue__Type"}},
+			{"NewQJsonValue2", []string{"bool"}},
+			{"NewQJsonValue3", []string{"float64"}},
+			{"NewQJsonValue4", []string{"int"}},
+			{"NewQJsonValue5", []string{"int64"}},
+			{"NewQJsonValue6", []string{"string"}},
+			{"NewQJsonValue7", []string{"*core.QLatin1String"}},
+			{"NewQJsonValue8", []string{"string"}},
+			{"NewQJsonValue9", []string{"*core.QJsonArray"}},
+			{"NewQJsonValue10", []string{"*core.QJsonObject"}},
+			{"NewQJsonValue11", []string{"*core.QJsonValue"}},
+			{"NewQJsonValue12", []string{"*core.QJsonValue"}},
+		},
+	},
+	"QKeyEvent": {
+		"QKeyEvent": {
+			{"NewQKeyEvent", []string{"core.QEvent__Type", "int", "core.Qt__KeyboardModifier", "string", "bool", "uint16"}},
+			{"NewQKeyEvent2", []string{"core.QEvent__Type", "int", "core.Qt__KeyboardModifier", "uint", "uint", "uint", "string", "bool", "uint16"}},
+		},
+	},
+	"QKeyEventTransition": {
+		"QKeyEventTransition": {
+			{"NewQKeyEventTransition", []string{"*core.QState"}},
+			{"NewQKeyEventTransition2", []string{"*core.QObject", "core.QEvent__Type", "int", "*core.QState"}},
+		},
+	},
+	"QKeySequence": {
+		"QKeySequence": {
+			{"NewQKeySequence", []string{}},
+			{"NewQKeySequence2", []string{"string", "gui.QKeySequence__SequenceFormat"}},
+			{"NewQKeySequence3", []string{"int", "int", "int", "int"}},
+			{"NewQKeySequence4", []string{"*gui.QKeySequence"}},
+			{"NewQKeySequence5", []string{"gui.QKeySequence__StandardKey"}},
+		},
+	},
+	"QKeySequenceEdit": {
+		"QKeySequenceEdit": {
+			{"NewQKeySequenceEdit", []string{"*widgets.QWidget"}},
+			{"NewQKeySequenceEdit2", []string{"*gui.QKeySequence", "*widgets.QWidget"}},
+		},
+	},
+	"QLCDNumber": {
+		"QLCDNumber": {
+			{"NewQLCDNumber", []string{"*widgets.QWidget"}},
+			{"NewQLCDNumber2", []string{"uint", "*widgets.QWidget"}},
+		},
+	},
+	"QLabel": {
+		"QLabel": {
+			{"NewQLabel", []string{"*widgets.QWidget", "core.Qt__WindowType"}},
+			{"NewQLabel2", []string{"string", "*widgets.QWidget", "core.Qt__WindowType"}},
+		},
+		"setNum": {
+			{"SetNum", []string{"int"}},
+			{"SetNum2", []string{"float64"}},
+		},
+	},
+	"QLayout": {
+		"QLayout": {
+			{"NewQLayout", []string{"*widgets.QWidget"}},
+			{"NewQLayout2", []string{}},
+		},
+		"setAlignment": {
+			{"SetAlignment", []string{"*widgets.QWidget", "core.Qt__AlignmentFlag"}},
+			{"SetAlignment2", []string{"*widgets.QLayout", "core.Qt__AlignmentFlag"}},
+		},
+		"setContentsMargins": {
+			{"SetContentsMargins", []string{"int", "int", "int", "int"}},
+			{"SetContentsMargins2", []string{"*core.QMargins"}},
+		},
+	},
+	"QLibrary": {
+		"QLibrary": {
+			{"NewQLibrary", []string{"*core.QObject"}},
+			{"NewQLibrary2", []string{"string", "*core.QObject"}},
+			{"NewQLibrary3", []string{"string", "int", "*core.QObject"}},
+			{"NewQLibrary4", []string{"string", "string", "*core.QObject"}},
+		},
+		"setFileNameAndVersion": {
+			{"SetFileNameAndVersion", []string{"string", "int"}},
+			{"SetFileNameAndVersion2", []string{"string", "string"}},
+		},
+	},
+	"QLine": {
+		"QLine": {
+			{"NewQLine", []string{}},
+			{"NewQLine2", []string{"*core.QPoint", "*core.QPoint"}},
+			{"NewQLine3", []string{"int", "int", "int", "int"}},
+		},
+		"setP": {
+			{"SetP1", []string{"*core.QPoint"}},
+			{"SetP2", []string{"*core.QPoint"}},
+		},
+	},
+	"QLineEdit": {
+		"QLineEdit": {
+			{"NewQLineEdit", []string{"*widgets.QWidget"}},
+			{"NewQLineEdit2", []string{"string", "*widgets.QWidget"}},
+		},
+		"addAction": {
+			{"AddAction", []string{"*widgets.QAction", "widgets.QLineEdit__ActionPosition"}},
+			{"AddAction2", []string{"*gui.QIcon", "widgets.QLineEdit__ActionPosition"}},
+		},
+		"setTextMargins": {
+			{"SetTextMargins", []string{"int", "int", "int", "int"}},
+			{"SetTextMargins2", []string{"*core.QMargins"}},
+		},
+	},
+	"QLineF": {
+		"QLineF": {
+			{"NewQLineF", []string{}},
+			{"NewQLineF2", []string{"*core.QPointF", "*core.QPointF"}},
+			{"NewQLineF3", []string{"float64", "float64", "float64", "float64"}},
+			{"NewQLineF4", []string{"*core.QLine"}},
+		},
+		"setP": {
+			{"SetP1", []string{"*core.QPointF"}},
+			{"SetP2", []string{"*core.QPointF"}},
+		},
+	},
+	"QLinearGradient": {
+		"QLinearGradient": {
+			{"NewQLinearGradient", []string{}},
+			{"NewQLinearGradient2", []string{"*core.QPointF", "*core.QPointF"}},
+			{"NewQLinearGradient3", []string{"float64", "float64", "float64", "float64"}},
+		},
+		"setFinalStop": {
+			{"SetFinalStop", []string{"*core.QPointF"}},
+			{"SetFinalStop2", []string{"float64", "float64"}},
+		},
+		"setStart": {
+			{"SetStart", []string{"*core.QPointF"}},
+			{"SetStart2", []string{"float64", "float64"}},
+		},
+	},
+	"QListView": {
+		"QListView": {
+			{"NewQListView", []string{"*widgets.QWidget"}},
+		},
+	},
+	"QListWidget": {
+		"QListWidget": {
+			{"NewQListWidget", []string{"*widgets.QWidget"}},
+		},
+		"addItem": {
+			{"AddItem", []string{"string"}},
+			{"AddItem2", []string{"*widgets.QListWidgetItem"}},
+		},
+		"insertItem": {
+			{"InsertItem", []string{"int", "*widgets.QListWidgetItem"}},
+			{"InsertItem2", []string{"int", "string"}},
+		},
+		"setCurrentItem": {
+			{"SetCurrentItem", []string{"*widgets.QListWidgetItem"}},
+			{"SetCurrentItem2", []string{"*widgets.QListWidgetItem", "core.QItemSelectionModel__SelectionFlag"}},
+		},
+		"setCurrentRow": {
+			{"SetCurrentRow", []string{"int"}},
+			{"SetCurrentRow2", []string{"int", "core.QItemSelectionModel__SelectionFlag"}},
+		},
+	},
+	"QListWidgetItem": {
+		"QListWidgetItem": {
+			{"NewQListWidgetItem", []string{"*widgets.QListWidget", "int"}},
+			{"NewQListWidgetItem2", []string{"string", "*widgets.QListWidget", "int"}},
+			{"NewQListWidgetItem3", []string{"*gui.QIcon", "string", "*widgets.QListWidget", "int"}},
+			{"NewQListWidgetItem4", []string{"*widgets.QListWidgetItem"}},
+		},
+	},
+	"QLocale": {
+		"QLocale": {
+			{"NewQLocale", []string{}},
+			{"NewQLocale2", []string{"string"}},
+			{"NewQLocale3", []string{"core.QLocale__Language", "core.QLocale__Country"}},
+			{"NewQLocale4", []string{"core.QLocale__Language", "core.QLocale__Script", "core.QLocale__Country"}},
+			{"NewQLocale5", []string{"*core.QLocale"}},
+		},
+	},
+	"QMainWindow": {
+		"QMainWindow": {
+			{"NewQMainWindow", []string{"*widgets.QWidget", "core.Qt__WindowType"}},
+		},
+		"addDockWidget": {
+			{"AddDockWidget", []string{"core.Qt__DockWidgetArea", "*widgets.QDockWidget"}},
+			{"AddDockWidget2", []string{"core.Qt__DockWidgetArea", "*widgets.QDockWidget", "core.Qt__Orientation"}},
+		},
+		"addToolBar": {
+			{"AddToolBar", []string{"core.Qt__ToolBarArea", "*widgets.QToolBar"}},
+			{"AddToolBar2", []string{"*widgets.QToolBar"}},
+			{"AddToolBar3", []string{"string"}},
+		},
+	},
+	"QMargins": {
+		"QMargins": {
+			{"NewQMargins", []string{}},
+			{"NewQMargins2", []string{"int", "int", "int", "int"}},
+		},
+	},
+	"QMarginsF": {
+		"QMarginsF": {
+			{"NewQMarginsF", []string{}},
+			{"NewQMarginsF2", []string{"float64", "float64", "float64", "float64"}},
+			{"NewQMarginsF3", []string{"*core.QMargins"}},
+		},
+	},
+	"QMatrix": {
+		"QMatrix": {
+			{"NewQMatrix2", []string{}},
+			{"NewQMatrix3", []string{"float64", "float64", "float64", "float64", "float64", "float64"}},
+			{"NewQMatrix5", []string{"*gui.QMatrix"}},
+		},
+	},
+	"QMdiArea": {
+		"QMdiArea": {
+			{"NewQMdiArea", []string{"*widgets.QWidget"}},
+		},
+	},
+	"QMdiSubWindow": {
+		"QMdiSubWindow": {
+			{"NewQMdiSubWindow", []string{"*widgets.QWidget", "core.Qt__WindowType"}},
+		},
+	},
+	"QMenu": {
+		"QMenu": {
+			{"NewQMenu", []string{"*widgets.QWidget"}},
+			{"NewQMenu2", []string{"string", "*widgets.QWidget"}},
+		},
+		"addAction": {
+			{"AddAction", []string{"string"}},
+			{"AddAction2", []string{"*gui.QIcon", "string"}},
+			{"AddAction3", []string{"string", "*core.QObject", "string", "*gui.QKeySequence"}},
+			{"AddAction4", []string{"*gui.QIcon", "string", "*core.QObject", "string", "*gui.QKeySequence"}},
+		},
+		"addMenu": {
+			{"AddMenu", []string{"*widgets.QMenu"}},
+			{"AddMenu2", []string{"string"}},
+			{"AddMenu3", []string{"*gui.QIcon", "string"}},
+		},
+		"addSection": {
+			{"AddSection", []string{"string"}},
+			{"AddSection2", []string{"*gui.QIcon", "string"}},
+		},
+		"insertSection": {
+			{"InsertSection", []string{"*widgets.QAction", "string"}},
+			{"InsertSection2", []string{"*widgets.QAction", "*gui.QIcon", "string"}},
+		},
+	},
+	"QMenuBar": {
+		"QMenuBar": {
+			{"NewQMenuBar", []string{"*widgets.QWidget"}},
+		},
+		"addAction": {
+			{"AddAction", []string{"string"}},
+			{"AddAction2", []string{"string", "*core.QObject", "string"}},
+		},
+		"addMenu": {
+			{"AddMenu", []string{"*widgets.QMenu"}},
+			{"AddMenu2", []string{"string"}},
+			{"AddMenu3", []string{"*gui.QIcon", "string"}},
+		},
+	},
+	"QMessageAuthenticationCode": {
+		"addData": {
+			{"AddData", []string{"[]byte", "int"}},
+			{"AddData2", []string{"*core.QByteArray"}},
+			{"AddData3", []string{"*core.QIODevice"}},
+		},
+	},
+	"QMessageBox": {
+		"QMessageBox": {
+			{"NewQMessageBox", []string{"*widgets.QWidget"}},
+			{"NewQMessageBox2", []string{"widgets.QMessageBox__Icon", "string", "string", "widgets.QMessageBox__StandardButton", "*widgets.QWidget", "core.Qt__WindowType"}},
+		},
+		"addButton": {
+			{"AddButton", []string{"*widgets.QAbstractButton", "widgets.QMessageBox__ButtonRole"}},
+			{"AddButton2", []string{"string", "widgets.QMessageBox__ButtonRole"}},
+			{"AddButton3", []string{"widgets.QMessageBox__StandardButton"}},
+		},
+		"setDefaultButton": {
+			{"SetDefaultButton", []string{"*widgets.QPushButton"}},
+			{"SetDefaultButton2", []string{"widgets.QMessageBox__StandardButton"}},
+		},
+		"setEscapeButton": {
+			{"SetEscapeButton", []string{"*widgets.QAbstractButton"}},
+			{"SetEscapeButton2", []string{"widgets.QMessageBox__StandardButton"}},
+		},
+	},
+	"QMessageLogger": {
+		"QMessageLogger": {
+			{"NewQMessageLogger2", []string{}},
+			{"NewQMessageLogger3", []string{"string", "int", "string"}},
+			{"NewQMessageLogger4", []string{"string", "int", "string", "string"}},
+		},
+	},
+	"QMimeType": {
+		"QMimeType": {
+			{"NewQMimeType", []string{}},
+			{"NewQMimeType2", []string{"*core.QMimeType"}},
+		},
+	},
+	"QMouseEvent": {
+		"QMouseEvent": {
+			{"NewQMouseEvent", []string{"core.QEvent__Type", "*core.QPointF", "core.Qt__MouseButton", "core.Qt__MouseButton", "core.Qt__KeyboardModifier"}},
+			{"NewQMouseEvent2", []string{"core.QEvent__Type", "*core.QPointF", "*core.QPointF", "core.Qt__MouseButton", "core.Qt__MouseButton", "core.Qt__KeyboardModifier"}},
+			{"NewQMouseEvent3", []string{"core.QEvent__Type", "*core.QPointF", "*core.QPointF", "*core.QPointF", "core.Qt__MouseButton", "core.Qt__MouseButton", "core.Qt__KeyboardModifier"}},
+			{"NewQMouseEvent4", []string{"core.QEvent__Type", "*core.QPointF", "*core.QPointF", "*core.QPointF", "core.Qt__MouseButton", "core.Qt__MouseButton", "core.Qt__KeyboardModifier", "core.Qt__MouseEventSource"}},
+		},
+	},
+	"QMouseEventTransition": {
+		"QMouseEventTransition": {
+			{"NewQMouseEventTransition", []string{"*core.QState"}},
+			{"NewQMouseEventTransition2", []string{"*core.QObject", "core.QEvent__Type", "core.Qt__MouseButton", "*core.QState"}},
+		},
+	},
+	"QMovie": {
+		"QMovie": {
+			{"NewQMovie", []string{"*core.QObject"}},
+			{"NewQMovie2", []string{"*core.QIODevice", "*core.QByteArray", "*core.QObject"}},
+			{"NewQMovie3", []string{"string", "*core.QByteArray", "*core.QObject"}},
+		},
+	},
+	"QOffscreenSurface": {
+		"QOffscreenSurface": {
+			{"NewQOffscreenSurface", []string{"*gui.QScreen", "*core.QObject"}},
+			{"NewQOffscreenSurface2", []string{"*gui.QScreen"}},
+		},
+	},
+	"QOpenGLBuffer": {
+		"QOpenGLBuffer": {
+			{"NewQOpenGLBuffer", []string{}},
+			{"NewQOpenGLBuffer2", []string{"gui.QOpenGLBuffer__Type"}},
+			{"NewQOpenGLBuffer3", []string{"*gui.QOpenGLBuffer"}},
+		},
+	},
+	"QOpenGLDebugMessage": {
+		"QOpenGLDebugMessage": {
+			{"NewQOpenGLDebugMessage", []string{}},
+			{"NewQOpenGLDebugMessage2", []string{"*gui.QOpenGLDebugMessage"}},
+		},
+	},
+	"QOpenGLExtraFunctions": {
+		"QOpenGLExtraFunctions": {
+			{"NewQOpenGLExtraFunctions", []string{}},
+			{"NewQOpenGLExtraFunctions2", []string{"*gui.QOpenGLContext"}},
+		},
+	},
+	"QOpenGLFramebufferObject": {
+		"QOpenGLFramebufferObject": {
+			{"NewQOpenGLFramebufferObject", []string{"*core.QSize", "uint"}},
+			{"NewQOpenGLFramebufferObject2", []string{"int", "int", "uint"}},
+			{"NewQOpenGLFramebufferObject3", []string{"*core.QSize", "gui.QOpenGLFramebufferObject__Attachment", "uint", "uint"}},
+			{"NewQOpenGLFramebufferObject4", []string{"int", "int", "gui.QOpenGLFramebufferObject__Attachment", "uint", "uint"}},
+			{"NewQOpenGLFramebufferObject5", []string{"*core.QSize", "*gui.QOpenGLFramebufferObjectFormat"}},
+			{"NewQOpenGLFramebufferObject6", []string{"int", "int", "*gui.QOpenGLFramebufferObjectFormat"}},
+		},
+		"addColorAttachment": {
+			{"AddColorAttachment", []string{"*core.QSize", "uint"}},
+			{"AddColorAttachment2", []string{"int", "int", "uint"}},
+		},
+	},
+	"QOpenGLFramebufferObjectFormat": {
+		"QOpenGLFramebufferObjectFormat": {
+			{"NewQOpenGLFramebufferObjectFormat", []string{}},
+			{"NewQOpenGLFramebufferObjectFormat2", []string{"*gui.QOpenGLFramebufferObjectFormat"}},
+		},
+	},
+	"QOpenGLFunctions": {
+		"QOpenGLFunctions": {
+			{"NewQOpenGLFunctions", []string{}},
+			{"NewQOpenGLFunctions2", []string{"*gui.QOpenGLContext"}},
+		},
+	},
+	"QOpenGLPaintDevice": {
+		"QOpenGLPaintDevice": {
+			{"NewQOpenGLPaintDevice", []string{}},
+			{"NewQOpenGLPaintDevice2", []string{"*core.QSize"}},
+			{"NewQOpenGLPaintDevice3", []string{"int", "int"}},
+		},
+	},
+	"QOpenGLShaderProgram": {
+		"addCacheableShaderFromSourceCode": {
+			{"AddCacheableShaderFromSourceCode", []string{"gui.QOpenGLShader__ShaderTypeBit", "string"}},
+			{"AddCacheableShaderFromSourceCode2", []string{"gui.QOpenGLShader__ShaderTypeBit", "*core.QByteArray"}},
+			{"AddCacheableShaderFromSourceCode3", []string{"gui.QOpenGLShader__ShaderTypeBit", "string"}},
+		},
+		"addShaderFromSourceCode": {
+			{"AddShaderFromSourceCode", []string{"gui.QOpenGLShader__ShaderTypeBit", "string"}},
+			{"AddShaderFromSourceCode2", []string{"gui.QOpenGLShader__ShaderTypeBit", "*core.QByteArray"}},
+			{"AddShaderFromSourceCode3", []string{"gui.QOpenGLShader__ShaderTypeBit", "string"}},
+		},
+		"setAttributeArray": {
+			{"SetAttributeArray", []string{"int", "float32", "int", "int"}},
+			{"SetAttributeArray2", []string{"int", "*gui.QVector2D", "int"}},
+			{"SetAttributeArray3", []string{"int", "*gui.QVector3D", "int"}},
+			{"SetAttributeArray4", []string{"int", "*gui.QVector4D", "int"}},
+			{"SetAttributeArray5", []string{"int", "uint", "unsafe.Pointer", "int", "int"}},
+			{"SetAttributeArray6", []string{"string", "float32", "int", "int"}},
+			{"SetAttributeArray7", []string{"string", "*gui.QVector2D", "int"}},
+			{"SetAttributeArray8", []string{"string", "*gui.QVector3D", "int"}},
+			{"SetAttributeArray9", []string{"string", "*gui.QVector4D", "int"}},
+			{"SetAttributeArray10", []string{"string", "uint", "unsafe.Pointer", "int", "int"}},
+		},
+		"setAttributeBuffer": {
+			{"SetAttributeBuffer", []string{"int", "uint", "int", "int", "int"}},
+			{"SetAttributeBuffer2", []string{"string", "uint", "int", "int", "int"}},
+		},
+		"setAttributeValue": {
+			{"SetAttributeValue", []string{"int", "float32"}},
+			{"SetAttributeValue2", []string{"int", "float32", "float32"}},
+			{"SetAttributeValue3", []string{"int", "float32", "float32", "float32"}},
+			{"SetAttributeValue4", []string{"int", "float32", "float32", "float32", "float32"}},
+			{"SetAttributeValue5", []string{"int", "*gui.QVector2D"}},
+			{"SetAttributeValue6", []string{"int", "*gui.QVector3D"}},
+			{"SetAttributeValue7", []string{"int", "*gui.QVector4D"}},
+			{"SetAttributeValue8", []string{"int", "*gui.QColor"}},
+			{"SetAttributeValue9", []string{"int", "float32", "int", "int"}},
+			{"SetAttributeValue10", []string{"string", "float32"}},
+			{"SetAttributeValue11", []string{"string", "float32", "float32"}},
+			{"SetAttributeValue12", []string{"string", "float32", "float32", "float32"}},
+			{"SetAttributeValue13", []string{"string", "float32", "float32", "float32", "float32"}},
+			{"SetAttributeValue14", []string{"string", "*gui.QVector2D"}},
+			{"SetAttributeValue15", []string{"string", "*gui.QVector3D"}},
+			{"SetAttributeValue16", []string{"string", "*gui.QVector4D"}},
+			{"SetAttributeValue17", []string{"string", "*gui.QColor"}},
+			{"SetAttributeValue18", []string{"string", "float32", "int", "int"}},
+		},
+		"setUniformValue": {
+			{"SetUniformValue", []string{"int", "float32"}},
+			{"SetUniformValue2", []string{"int", "int"}},
+			{"SetUniformValue3", []string{"int", "uint"}},
+			{"SetUniformValue4", []string{"int", "float32", "float32"}},
+			{"SetUniformValue5", []string{"int", "float32", "float32", "float32"}},
+			{"SetUniformValue6", []string{"int", "float32", "float32", "float32", "float32"}},
+			{"SetUniformValue7", []string{"int", "*gui.QVector2D"}},
+			{"SetUniformValue8", []string{"int", "*gui.QVector3D"}},
+			{"SetUniformValue9", []string{"int", "*gui.QVector4D"}},
+			{"SetUniformValue10", []string{"int", "*gui.QColor"}},
+			{"SetUniformValue11", []string{"int", "*core.QPoint"}},
+			{"SetUniformValue12", []string{"int", "*core.QPointF"}},
+			{"SetUniformValue13", []string{"int", "*core.QSize"}},
+			{"SetUniformValue14", []string{"int", "*core.QSizeF"}},
+			{"SetUniformValue23", []string{"int", "*gui.QMatrix4x4"}},
+			{"SetUniformValue27", []string{"int", "*gui.QTransform"}},
+			{"SetUniformValue28", []string{"string", "float32"}},
+			{"SetUniformValue29", []string{"string", "int"}},
+			{"SetUniformValue30", []string{"string", "uint"}},
+			{"SetUniformValue31", []string{"string", "float32", "float32"}},
+			{"SetUniformValue32", []string{"string", "float32", "float32", "float32"}},
+			{"SetUniformValue33", []string{"string", "float32", "float32", "float32", "float32"}},
+			{"SetUniformValue34", []string{"string", "*gui.QVector2D"}},
+			{"SetUniformValue35", []string{"string", "*gui.QVector3D"}},
+			{"SetUniformValue36", []string{"string", "*gui.QVector4D"}},
+			{"SetUniformValue37", []string{"string", "*gui.QColor"}},
+			{"SetUniformValue38", []string{"string", "*core.QPoint"}},
+			{"SetUniformValue39", []string{"string", "*core.QPointF"}},
+			{"SetUniformValue40", []string{"string", "*core.QSize"}},
+			{"SetUniformValue41", []string{"string", "*core.QSizeF"}},
+			{"SetUniformValue50", []string{"string", "*gui.QMatrix4x4"}},
+			{"SetUniformValue54", []string{"string", "*gui.QTransform"}},
+		},
+		"setUniformValueArray": {
+			{"SetUniformValueArray", []string{"int", "float32", "int", "int"}},
+			{"SetUniformValueArray2", []string{"int", "int", "int"}},
+			{"SetUniformValueArray3", []string{"int", "uint", "int"}},
+			{"SetUniformValueArray4", []string{"int", "*gui.QVector2D", "int"}},
+			{"SetUniformValueArray5", []string{"int", "*gui.QVector3D", "int"}},
+			{"SetUniformValueArray6", []string{"int", "*gui.QVector4D", "int"}},
+			{"SetUniformValueArray15", []string{"int", "*gui.QMatrix4x4", "int"}},
+			{"SetUniformValueArray16", []string{"string", "float32", "int", "int"}},
+			{"SetUniformValueArray17", []string{"string", "int", "int"}},
+			{"SetUniformValueArray18", []string{"string", "uint", "int"}},
+			{"SetUniformValueArray19", []string{"string", "*gui.QVector2D", "int"}},
+			{"SetUniformValueArray20", []string{"string", "*gui.QVector3D", "int"}},
+			{"SetUniformValueArray21", []string{"string", "*gui.QVector4D", "int"}},
+			{"SetUniformValueArray30", []string{"string", "*gui.QMatrix4x4", "int"}},
+		},
+	},
+	"QOpenGLTexture": {
+		"QOpenGLTexture": {
+			{"NewQOpenGLTexture", []string{"gui.QOpenGLTexture__Target"}},
+			{"NewQOpenGLTexture2", []string{"*gui.QImage", "gui.QOpenGLTexture__MipMapGeneration"}},
+		},
+		"setBorderColor": {
+			{"SetBorderColor", []string{"*gui.QColor"}},
+			{"SetBorderColor2", []string{"float32", "float32", "float32", "float32"}},
+			{"SetBorderColor3", []string{"int", "int", "int", "int"}},
+			{"SetBorderColor4", []string{"uint", "uint", "uint", "uint"}},
+		},
+		"setCompressedData": {
+			{"SetCompressedData", []string{"int", "int", "gui.QOpenGLTexture__CubeMapFace", "int", "unsafe.Pointer", "*gui.QOpenGLPixelTransferOptions"}},
+			{"SetCompressedData6", []string{"int", "int", "int", "gui.QOpenGLTexture__CubeMapFace", "int", "unsafe.Pointer", "*gui.QOpenGLPixelTransferOptions"}},
+			{"SetCompressedData7", []string{"int", "int", "int", "unsafe.Pointer", "*gui.QOpenGLPixelTransferOptions"}},
+			{"SetCompressedData8", []string{"int", "int", "unsafe.Pointer", "*gui.QOpenGLPixelTransferOptions"}},
+			{"SetCompressedData9", []string{"int", "unsafe.Pointer", "*gui.QOpenGLPixelTransferOptions"}},
+		},
+		"setData": {
+			{"SetData", []string{"int", "int", "gui.QOpenGLTexture__CubeMapFace", "gui.QOpenGLTexture__PixelFormat", "gui.QOpenGLTexture__PixelType", "unsafe.Pointer", "*gui.QOpenGLPixelTransferOptions"}},
+			{"SetData6", []string{"int", "int", "int", "gui.QOpenGLTexture__CubeMapFace", "gui.QOpenGLTexture__PixelFormat", "gui.QOpenGLTexture__PixelType", "unsafe.Pointer", "*gui.QOpenGLPixelTransferOptions"}},
+			{"SetData7", []string{"int", "int", "gui.QOpenGLTexture__PixelFormat", "gui.QOpenGLTexture__PixelType", "unsafe.Pointer", "*gui.QOpenGLPixelTransferOptions"}},
+			{"SetData8", []string{"int", "gui.QOpenGLTexture__PixelFormat", "gui.QOpenGLTexture__PixelType", "unsafe.Pointer", "*gui.QOpenGLPixelTransferOptions"}},
+			{"SetData9", []string{"gui.QOpenGLTexture__PixelFormat", "gui.QOpenGLTexture__PixelType", "unsafe.Pointer", "*gui.QOpenGLPixelTransferOptions"}},
+			{"SetData10", []string{"*gui.QImage", "gui.QOpenGLTexture__MipMapGeneration"}},
+		},
+		"setSwizzleMask": {
+			{"SetSwizzleMask", []string{"gui.QOpenGLTexture__SwizzleComponent", "gui.QOpenGLTexture__SwizzleValue"}},
+			{"SetSwizzleMask2", []string{"gui.QOpenGLTexture__SwizzleValue", "gui.QOpenGLTexture__SwizzleValue", "gui.QOpenGLTexture__SwizzleValue", "gui.QOpenGLTexture__SwizzleValue"}},
+		},
+		"setWrapMode": {
+			{"SetWrapMode", []string{"gui.QOpenGLTexture__WrapMode"}},
+			{"SetWrapMode2", []string{"gui.QOpenGLTexture__CoordinateDirection", "gui.QOpenGLTexture__WrapMode"}},
+		},
+	},
+	"QOpenGLVersionProfile": {
+		"QOpenGLVersionProfile": {
+			{"NewQOpenGLVersionProfile", []string{}},
+			{"NewQOpenGLVersionProfile2", []string{"*gui.QSurfaceFormat"}},
+			{"NewQOpenGLVersionProfile3", []string{"*gui.QOpenGLVersionProfile"}},
+		},
+	},
+	"QOpenGLWidget": {
+		"QOpenGLWidget": {
+			{"NewQOpenGLWidget", []string{"*widgets.QWidget", "core.Qt__WindowType"}},
+		},
+	},
+	"QOpenGLWindow": {
+		"QOpenGLWindow": {
+			{"NewQOpenGLWindow", []string{"gui.QOpenGLWindow__UpdateBehavior", "*gui.QWindow"}},
+			{"NewQOpenGLWindow2", []string{"*gui.QOpenGLContext", "gui.QOpenGLWindow__UpdateBehavior", "*gui.QWindow"}},
+		},
+	},
+	"QOperatingSystemVersion": {
+		"QOperatingSystemVersion": {
+			{"NewQOperatingSystemVersion", []string{"*core.QOperatingSystemVersion"}},
+			{"NewQOperatingSystemVersion2", []string{"core.QOperatingSystemVersion__OSType", "int", "int", "int"}},
+		},
+	},
+	"QPageLayout": {
+		"QPageLayout": {
+			{"NewQPageLayout", []string{}},
+			{"NewQPageLayout2", []string{"*gui.QPageSize", "gui.QPageLayout__Orientation", "*core.QMarginsF", "gui.QPageLayout__Unit", "*core.QMarginsF"}},
+			{"NewQPageLayout3", []string{"*gui.QPageLayout"}},
+		},
+	},
+	"QPageSize": {
+		"QPageSize": {
+			{"NewQPageSize", []string{}},
+			{"NewQPageSize2", []string{"gui.QPageSize__PageSizeId"}},
+			{"NewQPageSize3", []string{"*core.QSize", "string", "gui.QPageSize__SizeMatchPolicy"}},
+			{"NewQPageSize4", []string{"*core.QSizeF", "gui.QPageSize__Unit", "string", "gui.QPageSize__SizeMatchPolicy"}},
+			{"NewQPageSize5", []string{"*gui.QPageSize"}},
+		},
+	},
+	"QPagedPaintDevice": {
+		"setPageMargins": {
+			{"SetPageMargins", []string{"*core.QMarginsF"}},
+			{"SetPageMargins2", []string{"*core.QMarginsF", "gui.QPageLayout__Unit"}},
+		},
+		"setPageSize": {
+			{"SetPageSize", []string{"*gui.QPageSize"}},
+			{"SetPageSize2", []string{"gui.QPagedPaintDevice__PageSize"}},
+		},
+	},
+	"QPaintEvent": {
+		"QPaintEvent": {
+			{"NewQPaintEvent", []string{"*gui.QRegion"}},
+			{"NewQPaintEvent2", []string{"*core.QRect"}},
+		},
+	},
+	"QPainter": {
+		"QPainter": {
+			{"NewQPainter", []string{}},
+			{"NewQPainter2", []string{"*gui.QPaintDevice"}},
+		},
+		"setBrush": {
+			{"SetBrush", []string{"*gui.QBrush"}},
+			{"SetBrush2", []string{"core.Qt__BrushStyle"}},
+		},
+		"setBrushOrigin": {
+			{"SetBrushOrigin", []string{"*core.QPointF"}},
+			{"SetBrushOrigin2", []string{"int", "int"}},
+			{"SetBrushOrigin3", []string{"*core.QPoint"}},
+		},
+		"setClipRect": {
+			{"SetClipRect", []string{"*core.QRectF", "core.Qt__ClipOperation"}},
+			{"SetClipRect2", []string{"*core.QRect", "core.Qt__ClipOperation"}},
+			{"SetClipRect3", []string{"int", "int", "int", "int", "core.Qt__ClipOperation"}},
+		},
+		"setPen": {
+			{"SetPen", []string{"*gui.QPen"}},
+			{"SetPen2", []string{"*gui.QColor"}},
+			{"SetPen3", []string{"core.Qt__PenStyle"}},
+		},
+		"setViewport": {
+			{"SetViewport", []string{"*core.QRect"}},
+			{"SetViewport2", []string{"int", "int", "int", "int"}},
+		},
+		"setWindow": {
+			{"SetWindow", []string{"*core.QRect"}},
+			{"SetWindow2", []string{"int", "int", "int", "int"}},
+		},
+	},
+	"QPainterPath": {
+		"QPainterPath": {
+			{"NewQPainterPath", []string{}},
+			{"NewQPainterPath2", []string{"*core.QPointF"}},
+			{"NewQPainterPath3", []string{"*gui.QPainterPath"}},
+		},
+		"addEllipse": {
+			{"AddEllipse", []string{"*core.QRectF"}},
+			{"AddEllipse2", []string{"float64", "float64", "float64", "float64"}},
+			{"AddEllipse3", []string{"*core.QPointF", "float64", "float64"}},
+		},
+		"addRect": {
+			{"AddRect", []string{"*core.QRectF"}},
+			{"AddRect2", []string{"float64", "float64", "float64", "float64"}},
+		},
+		"addRoundedRect": {
+			{"AddRoundedRect", []string{"*core.QRectF", "float64", "float64", "core.Qt__SizeMode"}},
+			{"AddRoundedRect2", []string{"float64", "float64", "float64", "float64", "float64", "float64", "core.Qt__SizeMode"}},
+		},
+		"addText": {
+			{"AddText", []string{"*core.QPointF", "*gui.QFont", "string"}},
+			{"AddText2", []string{"float64", "float64", "*gui.QFont", "string"}},
+		},
+	},
+	"QPainterPathStroker": {
+		"QPainterPathStroker": {
+			{"NewQPainterPathStroker", []string{}},
+			{"NewQPainterPathStroker2", []string{"*gui.QPen"}},
+		},
+		"setDashPattern": {
+			{"SetDashPattern", []string{"core.Qt__PenStyle"}},
+			{"SetDashPattern2", []string{"[]float64"}},
+		},
+	},
+	"QPalette": {
+		"QPalette": {
+			{"NewQPalette", []string{}},
+			{"NewQPalette2", []string{"*gui.QColor"}},
+			{"NewQPalette3", []string{"core.Qt__GlobalColor"}},
+			{"NewQPalette4", []string{"*gui.QColor", "*gui.QColor"}},
+			{"NewQPalette5", []string{"*gui.QBrush", "*gui.QBrush", "*gui.QBrush", "*gui.QBrush", "*gui.QBrush", "*gui.QBrush", "*gui.QBrush", "*gui.QBrush", "*gui.QBrush"}},
+			{"NewQPalette7", []string{"*gui.QPalette"}},
+			{"NewQPalette8", []string{"*gui.QPalette"}},
+		},
+		"setBrush": {
+			{"SetBrush", []string{"gui.QPalette__ColorRole", "*gui.QBrush"}},
+			{"SetBrush2", []string{"gui.QPalette__ColorGroup", "gui.QPalette__ColorRole", "*gui.QBrush"}},
+		},
+		"setColor": {
+			{"SetColor", []string{"gui.QPalette__ColorGroup", "gui.QPalette__ColorRole", "*gui.QColor"}},
+			{"SetColor2", []string{"gui.QPalette__ColorRole", "*gui.QColor"}},
+		},
+	},
+	"QPauseAnimation": {
+		"QPauseAnimation": {
+			{"NewQPauseAnimation", []string{"*core.QObject"}},
+			{"NewQPauseAnimation2", []string{"int", "*core.QObject"}},
+		},
+	},
+	"QPdfWriter": {
+		"QPdfWriter": {
+			{"NewQPdfWriter", []string{"string"}},
+			{"NewQPdfWriter2", []string{"*core.QIODevice"}},
+		},
+	},
+	"QPen": {
+		"QPen": {
+			{"NewQPen", []string{}},
+			{"NewQPen2", []string{"core.Qt__PenStyle"}},
+			{"NewQPen3", []string{"*gui.QColor"}},
+			{"NewQPen4", []string{"*gui.QBrush", "float64", "core.Qt__PenStyle", "core.Qt__PenCapStyle", "core.Qt__PenJoinStyle"}},
+			{"NewQPen5", []string{"*gui.QPen"}},
+			{"NewQPen6", []string{"*gui.QPen"}},
+		},
+	},
+	"QPersistentModelIndex": {
+		"QPersistentModelIndex": {
+			{"NewQPersistentModelIndex2", []string{"*core.QModelIndex"}},
+			{"NewQPersistentModelIndex3", []string{"*core.QPersistentModelIndex"}},
+			{"NewQPersistentModelIndex4", []string{"*core.QPersistentModelIndex"}},
+		},
+	},
+	"QPicture": {
+		"QPicture": {
+			{"NewQPicture", []string{"int"}},
+			{"NewQPicture2", []string{"*gui.QPicture"}},
+		},
+	},
+	"QPictureIO": {
+		"QPictureIO": {
+			{"NewQPictureIO", []string{}},
+			{"NewQPictureIO2", []string{"*core.QIODevice", "string"}},
+			{"NewQPictureIO3", []string{"string", "string"}},
+		},
+	},
+	"QPixelFormat": {
+		"QPixelFormat": {
+			{"NewQPixelFormat", []string{}},
+			{"NewQPixelFormat2", []string{"gui.QPixelFormat__ColorModel", "string", "string", "string", "string", "string", "string", "gui.QPixelFormat__AlphaUsage", "gui.QPixelFormat__AlphaPosition", "gui.QPixelFormat__AlphaPremultiplied", "gui.QPixelFormat__TypeInterpretation", "gui.QPixelFormat__ByteOrder", "string"}},
+		},
+	},
+	"QPixmap": {
+		"QPixmap": {
+			{"NewQPixmap", []string{}},
+			{"NewQPixmap2", []string{"*core.QSize"}},
+			{"NewQPixmap3", []string{"string", "string", "core.Qt__ImageConversionFlag"}},
+			{"NewQPixmap5", []string{"*gui.QPixmap"}},
+		},
+	},
+	"QPlainTextEdit": {
+		"QPlainTextEdit": {
+			{"NewQPlainTextEdit", []string{"*widgets.QWidget"}},
+			{"NewQPlainTextEdit2", []string{"string", "*widgets.QWidget"}},
+		},
+	},
+	"QPluginLoader": {
+		"QPluginLoader": {
+			{"NewQPluginLoader", []string{"*core.QObject"}},
+			{"NewQPluginLoader2", []string{"string", "*core.QObject"}},
+		},
+	},
+	"QPoint": {
+		"QPoint": {
+			{"NewQPoint", []string{}},
+			{"NewQPoint2", []string{"int", "int"}},
+		},
+	},
+	"QPointF": {
+		"QPointF": {
+			{"NewQPointF", []string{}},
+			{"NewQPointF2", []string{"*core.QPoint"}},
+			{"NewQPointF3", []string{"float64", "float64"}},
+		},
+	},
+	"QPolygon": {
+		"QPolygon": {
+			{"NewQPolygon", []string{}},
+			{"NewQPolygon2", []string{"int"}},
+			{"NewQPolygon3", []string{"[]*core.QPoint"}},
+			{"NewQPolygon5", []string{"*core.QRect", "bool"}},
+		},
+		"setPoint": {
+			{"SetPoint", []string{"int", "int", "int"}},
+			{"SetPoint2", []string{"int", "*core.QPoint"}},
+		},
+	},
+	"QPolygonF": {
+		"QPolygonF": {
+			{"NewQPolygonF", []string{}},
+			{"NewQPolygonF2", []string{"int"}},
+			{"NewQPolygonF3", []string{"[]*core.QPointF"}},
+			{"NewQPolygonF5", []string{"*core.QRectF"}},
+			{"NewQPolygonF6", []string{"*gui.QPolygon"}},
+			{"NewQPolygonF7", []string{"*gui.QPolygonF"}},
+		},
+	},
+	"QProcessEnvironment": {
+		"QProcessEnvironment": {
+			{"NewQProcessEnvironment", []string{}},
+			{"NewQProcessEnvironment2", []string{"*core.QProcessEnvironment"}},
+		},
+		"insert": {
+			{"Insert", []string{"string", "string"}},
+			{"Insert2", []string{"*core.QProcessEnvironment"}},
+		},
+	},
+	"QProgressBar": {
+		"QProgressBar": {
+			{"NewQProgressBar", []string{"*widgets.QWidget"}},
+		},
+	},
+	"QProgressDialog": {
+		"QProgressDialog": {
+			{"NewQProgressDialog", []string{"*widgets.QWidget", "core.Qt__WindowType"}},
+			{"NewQProgressDialog2", []string{"string", "string", "int", "int", "*widgets.QWidget", "core.Qt__WindowType"}},
+		},
+	},
+	"QPropertyAnimation": {
+		"QPropertyAnimation": {
+			{"NewQPropertyAnimation", []string{"*core.QObject"}},
+			{"NewQPropertyAnimation2", []string{"*core.QObject", "*core.QByteArray", "*core.QObject"}},
+		},
+	},
+	"QProxyStyle": {
+		"QProxyStyle": {
+			{"NewQProxyStyle", []string{"*widgets.QStyle"}},
+			{"NewQProxyStyle2", []string{"string"}},
+		},
+	},
+	"QPushButton": {
+		"QPushButton": {
+			{"NewQPushButton", []string{"*widgets.QWidget"}},
+			{"NewQPushButton2", []string{"string", "*widgets.QWidget"}},
+			{"NewQPushButton3", []string{"*gui.QIcon", "string", "*widgets.QWidget"}},
+		},
+	},
+	"QQuaternion": {
+		"QQuaternion": {
+			{"NewQQuaternion", []string{}},
+			{"NewQQuaternion3", []string{"float32", "float32", "float32", "float32"}},
+			{"NewQQuaternion4", []string{"float32", "*gui.QVector3D"}},
+			{"NewQQuaternion5", []string{"*gui.QVector4D"}},
+		},
+		"setVector": {
+			{"SetVector", []string{"*gui.QVector3D"}},
+			{"SetVector2", []string{"float32", "float32", "float32"}},
+		},
+	},
+	"QRadialGradient": {
+		"QRadialGradient": {
+			{"NewQRadialGradient", []string{}},
+			{"NewQRadialGradient2", []string{"*core.QPointF", "float64", "*core.QPointF"}},
+			{"NewQRadialGradient3", []string{"float64", "float64", "float64", "float64", "float64"}},
+			{"NewQRadialGradient4", []string{"*core.QPointF", "float64"}},
+			{"NewQRadialGradient5", []string{"float64", "float64", "float64"}},
+			{"NewQRadialGradient6", []string{"*core.QPointF", "float64", "*core.QPointF", "float64"}},
+			{"NewQRadialGradient7", []string{"float64", "float64", "float64", "float64", "float64", "float64"}},
+		},
+		"setCenter": {
+			{"SetCenter", []string{"*core.QPointF"}},
+			{"SetCenter2", []string{"float64", "float64"}},
+		},
+		"setFocalPoint": {
+			{"SetFocalPoint", []string{"*core.QPointF"}},
+			{"SetFocalPoint2", []string{"float64", "float64"}},
+		},
+	},
+	"QRadioButton": {
+		"QRadioButton": {
+			{"NewQRadioButton", []string{"*widgets.QWidget"}},
+			{"NewQRadioButton2", []string{"string", "*widgets.QWidget"}},
+		},
+	},
+	"QRandomGenerator": {
+		"QRandomGenerator": {
+			{"NewQRandomGenerator", []string{"uint"}},
+			{"NewQRandomGenerator5", []string{"uint", "uint"}},
+			{"NewQRandomGenerator6", []string{"*core.QRandomGenerator"}},
+		},
+	},
+	"QRawFont": {
+		"QRawFont": {
+			{"NewQRawFont", []string{}},
+			{"NewQRawFont2", []string{"string", "float64", "gui.QFont__HintingPreference"}},
+			{"NewQRawFont3", []string{"*core.QByteArray", "float64", "gui.QFont__HintingPreference"}},
+			{"NewQRawFont4", []string{"*gui.QRawFont"}},
+		},
+	},
+	"QRect": {
+		"QRect": {
+			{"NewQRect", []string{}},
+			{"NewQRect2", []string{"*core.QPoint", "*core.QPoint"}},
+			{"NewQRect3", []string{"*core.QPoint", "*core.QSize"}},
+			{"NewQRect4", []string{"int", "int", "int", "int"}},
+		},
+	},
+	"QRectF": {
+		"QRectF": {
+			{"NewQRectF", []string{}},
+			{"NewQRectF2", []string{"*core.QPointF", "*core.QSizeF"}},
+			{"NewQRectF3", []string{"*core.QPointF", "*core.QPointF"}},
+			{"NewQRectF4", []string{"float64", "float64", "float64", "float64"}},
+			{"NewQRectF5", []string{"*core.QRect"}},
+		},
+	},
+	"QRegExp": {
+		"QRegExp": {
+			{"NewQRegExp", []string{}},
+			{"NewQRegExp2", []string{"string", "core.Qt__CaseSensitivity", "core.QRegExp__PatternSyntax"}},
+			{"NewQRegExp3", []string{"*core.QRegExp"}},
+		},
+	},
+	"QRegExpValidator": {
+		"QRegExpValidator": {
+			{"NewQRegExpValidator", []string{"*core.QObject"}},
+			{"NewQRegExpValidator2", []string{"*core.QRegExp", "*core.QObject"}},
+		},
+	},
+	"QRegion": {
+		"QRegion": {
+			{"NewQRegion", []string{}},
+			{"NewQRegion2", []string{"int", "int", "int", "int", "gui.QRegion__RegionType"}},
+			{"NewQRegion3", []string{"*core.QRect", "gui.QRegion__RegionType"}},
+			{"NewQRegion4", []string{"*gui.QPolygon", "core.Qt__FillRule"}},
+			{"NewQRegion5", []string{"*gui.QRegion"}},
+			{"NewQRegion6", []string{"*gui.QRegion"}},
+			{"NewQRegion7", []string{"*gui.QBitmap"}},
+		},
+	},
+	"QRegularExpression": {
+		"QRegularExpression": {
+			{"NewQRegularExpression", []string{}},
+			{"NewQRegularExpression2", []string{"string", "core.QRegularExpression__PatternOption"}},
+			{"NewQRegularExpression3", []string{"*core.QRegularExpression"}},
+		},
+	},
+	"QRegularExpressionMatch": {
+		"QRegularExpressionMatch": {
+			{"NewQRegularExpressionMatch", []string{}},
+			{"NewQRegularExpressionMatch2", []string{"*core.QRegularExpressionMatch"}},
+		},
+	},
+	"QRegularExpressionValidator": {
+		"QRegularExpressionValidator": {
+			{"NewQRegularExpressionValidator", []string{"*core.QObject"}},
+			{"NewQRegularExpressionValidator2", []string{"*core.QRegularExpression", "*core.QObject"}},
+		},
+	},
+	"QRubberBand": {
+		"QRubberBand": {
+			{"NewQRubberBand", []string{"widgets.QRubberBand__Shape", "*widgets.QWidget"}},
+		},
+	},
+	"QSaveFile": {
+		"QSaveFile": {
+			{"NewQSaveFile", []string{"string"}},
+			{"NewQSaveFile2", []string{"*core.QObject"}},
+			{"NewQSaveFile3", []string{"string", "*core.QObject"}},
+		},
+	},
+	"QScrollArea": {
+		"QScrollArea": {
+			{"NewQScrollArea", []string{"*widgets.QWidget"}},
+		},
+	},
+	"QScrollBar": {
+		"QScrollBar": {
+			{"NewQScrollBar", []string{"*widgets.QWidget"}},
+			{"NewQScrollBar2", []string{"core.Qt__Orientation", "*widgets.QWidget"}},
+		},
+	},
+	"QScroller": {
+		"setSnapPositionsX": {
+			{"SetSnapPositionsX", []string{"[]float64"}},
+			{"SetSnapPositionsX2", []string{"float64", "float64"}},
+		},
+		"setSnapPositionsY": {
+			{"SetSnapPositionsY", []string{"[]float64"}},
+			{"SetSnapPositionsY2", []string{"float64", "float64"}},
+		},
+	},
+	"QScrollerProperties": {
+		"QScrollerProperties": {
+			{"NewQScrollerProperties", []string{}},
+			{"NewQScrollerProperties2", []string{"*widgets.QScrollerProperties"}},
+		},
+	},
+	"QSemaphoreReleaser": {
+		"QSemaphoreReleaser": {
+			{"NewQSemaphoreReleaser", []string{}},
+			{"NewQSemaphoreReleaser2", []string{"*core.QSemaphore", "int"}},
+			{"NewQSemaphoreReleaser3", []string{"*core.QSemaphore", "int"}},
+		},
+	},
+	"QSessionManager": {
+		"setManagerProperty": {
+			{"SetManagerProperty", []string{"string", "[]string"}},
+			{"SetManagerProperty2", []string{"string", "string"}},
+		},
+	},
+	"QSettings": {
+		"QSettings": {
+			{"NewQSettings", []string{"string", "string", "*core.QObject"}},
+			{"NewQSettings2", []string{"core.QSettings__Scope", "string", "string", "*core.QObject"}},
+			{"NewQSettings3", []string{"core.QSettings__Format", "core.QSettings__Scope", "string", "string", "*core.QObject"}},
+			{"NewQSettings4", []string{"string", "core.QSettings__Format", "*core.QObject"}},
+			{"NewQSettings5", []string{"*core.QObject"}},
+			{"NewQSettings6", []string{"core.QSettings__Scope", "*core.QObject"}},
+		},
+		"setIniCodec": {
+			{"SetIniCodec", []string{"*core.QTextCodec"}},
+			{"SetIniCodec2", []string{"string"}},
+		},
+	},
+	"QSharedData": {
+		"QSharedData": {
+			{"NewQSharedData", []string{}},
+			{"NewQSharedData2", []string{"*core.QSharedData"}},
+		},
+	},
+	"QSharedMemory": {
+		"QSharedMemory": {
+			{"NewQSharedMemory", []string{"string", "*core.QObject"}},
+			{"NewQSharedMemory2", []string{"*core.QObject"}},
+		},
+	},
+	"QShortcut": {
+		"QShortcut": {
+			{"NewQShortcut", []string{"*widgets.QWidget"}},
+			{"NewQShortcut2", []string{"*gui.QKeySequence", "*widgets.QWidget", "string", "string", "core.Qt__ShortcutContext"}},
+		},
+	},
+	"QSignalBlocker": {
+		"QSignalBlocker": {
+			{"NewQSignalBlocker", []string{"*core.QObject"}},
+			{"NewQSignalBlocker2", []string{"*core.QObject"}},
+		},
+	},
+	"QSignalTransition": {
+		"QSignalTransition": {
+			{"NewQSignalTransition", []string{"*core.QState"}},
+			{"NewQSignalTransition2", []string{"*core.QObject", "string", "*core.QState"}},
+		},
+	},
+	"QSize": {
+		"QSize": {
+			{"NewQSize", []string{}},
+			{"NewQSize2", []string{"int", "int"}},
+		},
+	},
+	"QSizeF": {
+		"QSizeF": {
+			{"NewQSizeF", []string{}},
+			{"NewQSizeF2", []string{"*core.QSize"}},
+			{"NewQSizeF3", []string{"float64", "float64"}},
+		},
+	},
+	"QSizeGrip": {
+		"QSizeGrip": {
+			{"NewQSizeGrip", []string{"*widgets.QWidget"}},
+		},
+	},
+	"QSizePolicy": {
+		"QSizePolicy": {
+			{"NewQSizePolicy", []string{}},
+			{"NewQSizePolicy2", []string{"widgets.QSizePolicy__Policy", "widgets.QSizePolicy__Policy", "widgets.QSizePolicy__ControlType"}},
+		},
+	},
+	"QSlider": {
+		"QSlider": {
+			{"NewQSlider", []string{"*widgets.QWidget"}},
+			{"NewQSlider2", []string{"core.Qt__Orientation", "*widgets.QWidget"}},
+		},
+	},
+	"QSortFilterProxyModel": {
+		"setFilterRegExp": {
+			{"SetFilterRegExp", []string{"*core.QRegExp"}},
+			{"SetFilterRegExp2", []string{"string"}},
+		},
+		"setFilterRegularExpression": {
+			{"SetFilterRegularExpression", []string{"string"}},
+			{"SetFilterRegularExpression2", []string{"*core.QRegularExpression"}},
+		},
+	},
+	"QSpacerItem": {
+		"QSpacerItem": {
+			{"NewQSpacerItem", []string{"int", "int", "widgets.QSizePolicy__Policy", "widgets.QSizePolicy__Policy"}},
+		},
+	},
+	"QSpinBox": {
+		"QSpinBox": {
+			{"NewQSpinBox", []string{"*widgets.QWidget"}},
+		},
+	},
+	"QSplashScreen": {
+		"QSplashScreen": {
+			{"NewQSplashScreen", []string{"*gui.QPixmap", "core.Qt__WindowType"}},
+			{"NewQSplashScreen2", []string{"*widgets.QWidget", "*gui.QPixmap", "core.Qt__WindowType"}},
+		},
+	},
+	"QSplitter": {
+		"QSplitter": {
+			{"NewQSplitter", []string{"*widgets.QWidget"}},
+			{"NewQSplitter2", []string{"core.Qt__Orientation", "*widgets.QWidget"}},
+		},
+	},
+	"QSplitterHandle": {
+		"QSplitterHandle": {
+			{"NewQSplitterHandle", []string{"core.Qt__Orientation", "*widgets.QSplitter"}},
+		},
+	},
+	"QStackedLayout": {
+		"QStackedLayout": {
+			{"NewQStackedLayout", []string{}},
+			{"NewQStackedLayout2", []string{"*widgets.QWidget"}},
+			{"NewQStackedLayout3", []string{"*widgets.QLayout"}},
+		},
+	},
+	"QStackedWidget": {
+		"QStackedWidget": {
+			{"NewQStackedWidget", []string{"*widgets.QWidget"}},
+		},
+	},
+	"QStandardItem": {
+		"QStandardItem": {
+			{"NewQStandardItem", []string{}},
+			{"NewQStandardItem2", []string{"string"}},
+			{"NewQStandardItem3", []string{"*gui.QIcon", "string"}},
+			{"NewQStandardItem4", []string{"int", "int"}},
+			{"NewQStandardItem5", []string{"*gui.QStandardItem"}},
+		},
+		"insertRow": {
+			{"InsertRow", []string{"int", "[]*gui.QStandardItem"}},
+			{"InsertRow2", []string{"int", "*gui.QStandardItem"}},
+		},
+		"insertRows": {
+			{"InsertRows", []string{"int", "[]*gui.QStandardItem"}},
+			{"InsertRows2", []string{"int", "int"}},
+		},
+		"setChild": {
+			{"SetChild", []string{"int", "int", "*gui.QStandardItem"}},
+			{"SetChild2", []string{"int", "*gui.QStandardItem"}},
+		},
+	},
+	"QStandardItemModel": {
+		"QStandardItemModel": {
+			{"NewQStandardItemModel", []string{"*core.QObject"}},
+			{"NewQStandardItemModel2", []string{"int", "int", "*core.QObject"}},
+		},
+		"insertRow": {
+			{"InsertRow", []string{"int", "[]*gui.QStandardItem"}},
+			{"InsertRow2", []string{"int", "*gui.QStandardItem"}},
+		},
+		"setItem": {
+			{"SetItem", []string{"int", "int", "*gui.QStandardItem"}},
+			{"SetItem2", []string{"int", "*gui.QStandardItem"}},
+		},
+	},
+	"QState": {
+		"QState": {
+			{"NewQState", []string{"*core.QState"}},
+			{"NewQState2", []string{"core.QState__ChildMode", "*core.QState"}},
+		},
+		"addTransition": {
+			{"AddTransition", []string{"*core.QAbstractTransition"}},
+			{"AddTransition2", []string{"*core.QObject", "string", "*core.QAbstractState"}},
+			{"AddTransition4", []string{"*core.QAbstractState"}},
+		},
+	},
+	"QStateMachine": {
+		"QStateMachine": {
+			{"NewQStateMachine", []string{"*core.QObject"}},
+			{"NewQStateMachine2", []string{"core.QState__ChildMode", "*core.QObject"}},
+		},
+	},
+	"QStaticText": {
+		"QStaticText": {
+			{"NewQStaticText", []string{}},
+			{"NewQStaticText2", []string{"string"}},
+			{"NewQStaticText3", []string{"*gui.QStaticText"}},
+		},
+	},
+	"QStatusBar": {
+		"QStatusBar": {
+			{"NewQStatusBar", []string{"*widgets.QWidget"}},
+		},
+	},
+	"QStorageInfo": {
+		"QStorageInfo": {
+			{"NewQStorageInfo", []string{}},
+			{"NewQStorageInfo2", []string{"string"}},
+			{"NewQStorageInfo3", []string{"*core.QDir"}},
+			{"NewQStorageInfo4", []string{"*core.QStorageInfo"}},
+		},
+	},
+	"QStringListModel": {
+		"QStringListModel": {
+			{"NewQStringListModel", []string{"*core.QObject"}},
+			{"NewQStringListModel2", []string{"[]string", "*core.QObject"}},
+		},
+	},
+	"QStringMatcher": {
+		"QStringMatcher": {
+			{"NewQStringMatcher", []string{}},
+			{"NewQStringMatcher2", []string{"string", "core.Qt__CaseSensitivity"}},
+			{"NewQStringMatcher3", []string{"*core.QChar", "int", "core.Qt__CaseSensitivity"}},
+			{"NewQStringMatcher4", []string{"*core.QStringMatcher"}},
+		},
+	},
+	"QStringRef": {
+		"QStringRef": {
+			{"NewQStringRef", []string{}},
+			{"NewQStringRef2", []string{"string", "int", "int"}},
+			{"NewQStringRef3", []string{"string"}},
+			{"NewQStringRef4", []string{"*core.QStringRef"}},
+		},
+	},
+	"QStringView": {
+		"QStringView": {
+			{"NewQStringView", []string{}},
+			{"NewQStringView7", []string{"string"}},
+			{"NewQStringView8", []string{"*core.QStringRef"}},
+		},
+	},
+	"QStyleOption": {
+		"QStyleOption": {
+			{"NewQStyleOption", []string{"int", "int"}},
+			{"NewQStyleOption2", []string{"*widgets.QStyleOption"}},
+		},
+	},
+	"QStyleOptionButton": {
+		"QStyleOptionButton": {
+			{"NewQStyleOptionButton", []string{}},
+			{"NewQStyleOptionButton2", []string{"*widgets.QStyleOptionButton"}},
+		},
+	},
+	"QStyleOptionComboBox": {
+		"QStyleOptionComboBox": {
+			{"NewQStyleOptionComboBox", []string{}},
+			{"NewQStyleOptionComboBox2", []string{"*widgets.QStyleOptionComboBox"}},
+		},
+	},
+	"QStyleOptionComplex": {
+		"QStyleOptionComplex": {
+			{"NewQStyleOptionComplex", []string{"int", "int"}},
+			{"NewQStyleOptionComplex2", []string{"*widgets.QStyleOptionComplex"}},
+		},
+	},
+	"QStyleOptionDockWidget": {
+		"QStyleOptionDockWidget": {
+			{"NewQStyleOptionDockWidget", []string{}},
+			{"NewQStyleOptionDockWidget2", []string{"*widgets.QStyleOptionDockWidget"}},
+		},
+	},
+	"QStyleOptionFocusRect": {
+		"QStyleOptionFocusRect": {
+			{"NewQStyleOptionFocusRect", []string{}},
+			{"NewQStyleOptionFocusRect2", []string{"*widgets.QStyleOptionFocusRect"}},
+		},
+	},
+	"QStyleOptionFrame": {
+		"QStyleOptionFrame": {
+			{"NewQStyleOptionFrame", []string{}},
+			{"NewQStyleOptionFrame2", []string{"*widgets.QStyleOptionFrame"}},
+		},
+	},
+	"QStyleOptionGraphicsItem": {
+		"QStyleOptionGraphicsItem": {
+			{"NewQStyleOptionGraphicsItem", []string{}},
+			{"NewQStyleOptionGraphicsItem2", []string{"*widgets.QStyleOptionGraphicsItem"}},
+		},
+	},
+	"QStyleOptionGroupBox": {
+		"QStyleOptionGroupBox": {
+			{"NewQStyleOptionGroupBox", []string{}},
+			{"NewQStyleOptionGroupBox2", []string{"*widgets.QStyleOptionGroupBox"}},
+		},
+	},
+	"QStyleOptionHeader": {
+		"QStyleOptionHeader": {
+			{"NewQStyleOptionHeader", []string{}},
+			{"NewQStyleOptionHeader2", []string{"*widgets.QStyleOptionHeader"}},
+		},
+	},
+	"QStyleOptionMenuItem": {
+		"QStyleOptionMenuItem": {
+			{"NewQStyleOptionMenuItem", []string{}},
+			{"NewQStyleOptionMenuItem2", []string{"*widgets.QStyleOptionMenuItem"}},
+		},
+	},
+	"QStyleOptionProgressBar": {
+		"QStyleOptionProgressBar": {
+			{"NewQStyleOptionProgressBar", []string{}},
+			{"NewQStyleOptionProgressBar2", []string{"*widgets.QStyleOptionProgressBar"}},
+		},
+	},
+	"QStyleOptionRubberBand": {
+		"QStyleOptionRubberBand": {
+			{"NewQStyleOptionRubberBand", []string{}},
+			{"NewQStyleOptionRubberBand2", []string{"*widgets.QStyleOptionRubberBand"}},
+		},
+	},
+	"QStyleOptionSizeGrip": {
+		"QStyleOptionSizeGrip": {
+			{"NewQStyleOptionSizeGrip", []string{}},
+			{"NewQStyleOptionSizeGrip2", []string{"*widgets.QStyleOptionSizeGrip"}},
+		},
+	},
+	"QStyleOptionSlider": {
+		"QStyleOptionSlider": {
+			{"NewQStyleOptionSlider", []string{}},
+			{"NewQStyleOptionSlider2", []string{"*widgets.QStyleOptionSlider"}},
+		},
+	},
+	"QStyleOptionSpinBox": {
+		"QStyleOptionSpinBox": {
+			{"NewQStyleOptionSpinBox", []string{}},
+			{"NewQStyleOptionSpinBox2", []string{"*widgets.QStyleOptionSpinBox"}},
+		},
+	},
+	"QStyleOptionTab": {
+		"QStyleOptionTab": {
+			{"NewQStyleOptionTab", []string{}},
+			{"NewQStyleOptionTab2", []string{"*widgets.QStyleOptionTab"}},
+		},
+	},
+	"QStyleOptionTabBarBase": {
+		"QStyleOptionTabBarBase": {
+			{"NewQStyleOptionTabBarBase", []string{}},
+			{"NewQStyleOptionTabBarBase2", []string{"*widgets.QStyleOptionTabBarBase"}},
+		},
+	},
+	"QStyleOptionTabWidgetFrame": {
+		"QStyleOptionTabWidgetFrame": {
+			{"NewQStyleOptionTabWidgetFrame", []string{}},
+			{"NewQStyleOptionTabWidgetFrame2", []string{"*widgets.QStyleOptionTabWidgetFrame"}},
+		},
+	},
+	"QStyleOptionTitleBar": {
+		"QStyleOptionTitleBar": {
+			{"NewQStyleOptionTitleBar", []string{}},
+			{"NewQStyleOptionTitleBar2", []string{"*widgets.QStyleOptionTitleBar"}},
+		},
+	},
+	"QStyleOptionToolBar": {
+		"QStyleOptionToolBar": {
+			{"NewQStyleOptionToolBar", []string{}},
+			{"NewQStyleOptionToolBar2", []string{"*widgets.QStyleOptionToolBar"}},
+		},
+	},
+	"QStyleOptionToolBox": {
+		"QStyleOptionToolBox": {
+			{"NewQStyleOptionToolBox", []string{}},
+			{"NewQStyleOptionToolBox2", []string{"*widgets.QStyleOptionToolBox"}},
+		},
+	},
+	"QStyleOptionToolButton": {
+		"QStyleOptionToolButton": {
+			{"NewQStyleOptionToolButton", []string{}},
+			{"NewQStyleOptionToolButton2", []string{"*widgets.QStyleOptionToolButton"}},
+		},
+	},
+	"QStyleOptionViewItem": {
+		"QStyleOptionViewItem": {
+			{"NewQStyleOptionViewItem", []string{}},
+			{"NewQStyleOptionViewItem2", []string{"*widgets.QStyleOptionViewItem"}},
+		},
+	},
+	"QStylePainter": {
+		"QStylePainter": {
+			{"NewQStylePainter", []string{}},
+			{"NewQStylePainter2", []string{"*widgets.QWidget"}},
+			{"NewQStylePainter3", []string{"*gui.QPaintDevice", "*widgets.QWidget"}},
+		},
+	},
+	"QSurfaceFormat": {
+		"QSurfaceFormat": {
+			{"NewQSurfaceFormat", []string{}},
+			{"NewQSurfaceFormat2", []string{"gui.QSurfaceFormat__FormatOption"}},
+			{"NewQSurfaceFormat3", []string{"*gui.QSurfaceFormat"}},
+		},
+	},
+	"QSyntaxHighlighter": {
+		"QSyntaxHighlighter": {
+			{"NewQSyntaxHighlighter", []string{"*core.QObject"}},
+			{"NewQSyntaxHighlighter2", []string{"*gui.QTextDocument"}},
+		},
+		"setFormat": {
+			{"SetFormat", []string{"int", "int", "*gui.QTextCharFormat"}},
+			{"SetFormat2", []string{"int", "int", "*gui.QColor"}},
+			{"SetFormat3", []string{"int", "int", "*gui.QFont"}},
+		},
+	},
+	"QSystemTrayIcon": {
+		"QSystemTrayIcon": {
+			{"NewQSystemTrayIcon", []string{"*core.QObject"}},
+			{"NewQSystemTrayIcon2", []string{"*gui.QIcon", "*core.QObject"}},
+		},
+	},
+	"QTabBar": {
+		"QTabBar": {
+			{"NewQTabBar", []string{"*widgets.QWidget"}},
+		},
+		"addTab": {
+			{"AddTab", []string{"string"}},
+			{"AddTab2", []string{"*gui.QIcon", "string"}},
+		},
+		"insertTab": {
+			{"InsertTab", []string{"int", "string"}},
+			{"InsertTab2", []string{"int", "*gui.QIcon", "string"}},
+		},
+	},
+	"QTabWidget": {
+		"QTabWidget": {
+			{"NewQTabWidget", []string{"*widgets.QWidget"}},
+		},
+		"addTab": {
+			{"AddTab", []string{"*widgets.QWidget", "string"}},
+			{"AddTab2", []string{"*widgets.QWidget", "*gui.QIcon", "string"}},
+		},
+		"insertTab": {
+			{"InsertTab", []string{"int", "*widgets.QWidget", "string"}},
+			{"InsertTab2", []string{"int", "*widgets.QWidget", "*gui.QIcon", "string"}},
+		},
+	},
+	"QTableView": {
+		"QTableView": {
+			{"NewQTableView", []string{"*widgets.QWidget"}},
+		},
+	},
+	"QTableWidget": {
+		"QTableWidget": {
+			{"NewQTableWidget", []string{"*widgets.QWidget"}},
+			{"NewQTableWidget2", []string{"int", "int", "*widgets.QWidget"}},
+		},
+		"setCurrentCell": {
+			{"SetCurrentCell", []string{"int", "int"}},
+			{"SetCurrentCell2", []string{"int", "int", "core.QItemSelectionModel__SelectionFlag"}},
+		},
+		"setCurrentItem": {
+			{"SetCurrentItem", []string{"*widgets.QTableWidgetItem"}},
+			{"SetCurrentItem2", []string{"*widgets.QTableWidgetItem", "core.QItemSelectionModel__SelectionFlag"}},
+		},
+	},
+	"QTableWidgetItem": {
+		"QTableWidgetItem": {
+			{"NewQTableWidgetItem", []string{"int"}},
+			{"NewQTableWidgetItem2", []string{"string", "int"}},
+			{"NewQTableWidgetItem3", []string{"*gui.QIcon", "string", "int"}},
+			{"NewQTableWidgetItem4", []string{"*widgets.QTableWidgetItem"}},
+		},
+	},
+	"QTableWidgetSelectionRange": {
+		"QTableWidgetSelectionRange": {
+			{"NewQTableWidgetSelectionRange", []string{}},
+			{"NewQTableWidgetSelectionRange2", []string{"int", "int", "int", "int"}},
+			{"NewQTableWidgetSelectionRange3", []string{"*widgets.QTableWidgetSelectionRange"}},
+		},
+	},
+	"QTemporaryDir": {
+		"QTemporaryDir": {
+			{"NewQTemporaryDir", []string{}},
+			{"NewQTemporaryDir2", []string{"string"}},
+		},
+	},
+	"QTemporaryFile": {
+		"QTemporaryFile": {
+			{"NewQTemporaryFile", []string{}},
+			{"NewQTemporaryFile2", []string{"string"}},
+			{"NewQTemporaryFile3", []string{"*core.QObject"}},
+			{"NewQTemporaryFile4", []string{"string", "*core.QObject"}},
+		},
+	},
+	"QTextBoundaryFinder": {
+		"QTextBoundaryFinder": {
+			{"NewQTextBoundaryFinder", []string{}},
+			{"NewQTextBoundaryFinder2", []string{"*core.QTextBoundaryFinder"}},
+			{"NewQTextBoundaryFinder3", []string{"core.QTextBoundaryFinder__BoundaryType", "string"}},
+		},
+	},
+	"QTextBrowser": {
+		"QTextBrowser": {
+			{"NewQTextBrowser", []string{"*widgets.QWidget"}},
+		},
+	},
+	"QTextCharFormat": {
+		"setFont": {
+			{"SetFont", []string{"*gui.QFont", "gui.QTextCharFormat__FontPropertiesInheritanceBehavior"}},
+			{"SetFont2", []string{"*gui.QFont"}},
+		},
+	},
+	"QTextCursor": {
+		"QTextCursor": {
+			{"NewQTextCursor", []string{}},
+			{"NewQTextCursor2", []string{"*gui.QTextDocument"}},
+			{"NewQTextCursor3", []string{"*gui.QTextFrame"}},
+			{"NewQTextCursor4", []string{"*gui.QTextBlock"}},
+			{"NewQTextCursor5", []string{"*gui.QTextCursor"}},
+		},
+		"insertBlock": {
+			{"InsertBlock", []string{}},
+			{"InsertBlock2", []string{"*gui.QTextBlockFormat"}},
+			{"InsertBlock3", []string{"*gui.QTextBlockFormat", "*gui.QTextCharFormat"}},
+		},
+		"insertImage": {
+			{"InsertImage", []string{"*gui.QTextImageFormat"}},
+			{"InsertImage2", []string{"*gui.QTextImageFormat", "gui.QTextFrameFormat__Position"}},
+			{"InsertImage3", []string{"string"}},
+			{"InsertImage4", []string{"*gui.QImage", "string"}},
+		},
+		"insertList": {
+			{"InsertList", []string{"*gui.QTextListFormat"}},
+			{"InsertList2", []string{"gui.QTextListFormat__Style"}},
+		},
+		"insertTable": {
+			{"InsertTable", []string{"int", "int", "*gui.QTextTableFormat"}},
+			{"InsertTable2", []string{"int", "int"}},
+		},
+		"insertText": {
+			{"InsertText", []string{"string"}},
+			{"InsertText2", []string{"string", "*gui.QTextCharFormat"}},
+		},
+	},
+	"QTextDecoder": {
+		"QTextDecoder": {
+			{"NewQTextDecoder2", []string{"*core.QTextCodec"}},
+			{"NewQTextDecoder3", []string{"*core.QTextCodec", "core.QTextCodec__ConversionFlag"}},
+		},
+	},
+	"QTextDocument": {
+		"QTextDocument": {
+			{"NewQTextDocument", []string{"*core.QObject"}},
+			{"NewQTextDocument2", []string{"string", "*core.QObject"}},
+		},
+	},
+	"QTextDocumentFragment": {
+		"QTextDocumentFragment": {
+			{"NewQTextDocumentFragment", []string{}},
+			{"NewQTextDocumentFragment2", []string{"*gui.QTextDocument"}},
+			{"NewQTextDocumentFragment3", []string{"*gui.QTextCursor"}},
+			{"NewQTextDocumentFragment4", []string{"*gui.QTextDocumentFragment"}},
+		},
+	},
+	"QTextDocumentWriter": {
+		"QTextDocumentWriter": {
+			{"NewQTextDocumentWriter", []string{}},
+			{"NewQTextDocumentWriter2", []string{"*core.QIODevice", "*core.QByteArray"}},
+			{"NewQTextDocumentWriter3", []string{"string", "*core.QByteArray"}},
+		},
+	},
+	"QTextEdit": {
+		"QTextEdit": {
+			{"NewQTextEdit", []string{"*widgets.QWidget"}},
+			{"NewQTextEdit2", []string{"string", "*widgets.QWidget"}},
+		},
+	},
+	"QTextEncoder": {
+		"QTextEncoder": {
+			{"NewQTextEncoder2", []string{"*core.QTextCodec"}},
+			{"NewQTextEncoder3", []string{"*core.QTextCodec", "core.QTextCodec__ConversionFlag"}},
+		},
+	},
+	"QTextFormat": {
+		"QTextFormat": {
+			{"NewQTextFormat", []string{}},
+			{"NewQTextFormat2", []string{"int"}},
+			{"NewQTextFormat3", []string{"*gui.QTextFormat"}},
+		},
+		"setProperty": {
+			{"SetProperty", []string{"int", "*core.QVariant"}},
+			{"SetProperty2", []string{"int", "[]*gui.QTextLength"}},
+		},
+	},
+	"QTextFragment": {
+		"QTextFragment": {
+			{"NewQTextFragment2", []string{}},
+			{"NewQTextFragment3", []string{"*gui.QTextFragment"}},
+		},
+	},
+	"QTextFrameFormat": {
+		"setHeight": {
+			{"SetHeight", []string{"*gui.QTextLength"}},
+			{"SetHeight2", []string{"float64"}},
+		},
+		"setWidth": {
+			{"SetWidth", []string{"*gui.QTextLength"}},
+			{"SetWidth2", []string{"float64"}},
+		},
+	},
+	"QTextLayout": {
+		"QTextLayout": {
+			{"NewQTextLayout", []string{}},
+			{"NewQTextLayout2", []string{"string"}},
+			{"NewQTextLayout4", []string{"string", "*gui.QFont", "*gui.QPaintDevice"}},
+		},
+	},
+	"QTextLength": {
+		"QTextLength": {
+			{"NewQTextLength", []string{}},
+			{"NewQTextLength2", []string{"gui.QTextLength__Type", "float64"}},
+		},
+	},
+	"QTextLine": {
+		"setNumColumns": {
+			{"SetNumColumns", []string{"int"}},
+			{"SetNumColumns2", []string{"int", "float64"}},
+		},
+	},
+	"QTextOption": {
+		"QTextOption": {
+			{"NewQTextOption", []string{}},
+			{"NewQTextOption2", []string{"core.Qt__AlignmentFlag"}},
+			{"NewQTextOption3", []string{"*gui.QTextOption"}},
+		},
+	},
+	"QTextStream": {
+		"QTextStream": {
+			{"NewQTextStream", []string{}},
+			{"NewQTextStream2", []string{"*core.QIODevice"}},
+			{"NewQTextStream4", []string{"string", "core.QIODevice__OpenModeFlag"}},
+			{"NewQTextStream5", []string{"*core.QByteArray", "core.QIODevice__OpenModeFlag"}},
+			{"NewQTextStream6", []string{"*core.QByteArray", "core.QIODevice__OpenModeFlag"}},
+		},
+		"setCodec": {
+			{"SetCodec", []string{"*core.QTextCodec"}},
+			{"SetCodec2", []string{"string"}},
+		},
+	},
+	"QTextTableCell": {
+		"QTextTableCell": {
+			{"NewQTextTableCell", []string{}},
+			{"NewQTextTableCell2", []string{"*gui.QTextTableCell"}},
+		},
+	},
+	"QTileRules": {
+		"QTileRules": {
+			{"NewQTileRules", []string{"core.Qt__TileRule", "core.Qt__TileRule"}},
+			{"NewQTileRules2", []string{"core.Qt__TileRule"}},
+		},
+	},
+	"QTime": {
+		"QTime": {
+			{"NewQTime2", []string{}},
+			{"NewQTime3", []string{"int", "int", "int", "int"}},
+		},
+	},
+	"QTimeEdit": {
+		"QTimeEdit": {
+			{"NewQTimeEdit", []string{"*widgets.QWidget"}},
+			{"NewQTimeEdit2", []string{"*core.QTime", "*widgets.QWidget"}},
+		},
+	},
+	"QTimeZone": {
+		"QTimeZone": {
+			{"NewQTimeZone", []string{}},
+			{"NewQTimeZone2", []string{"*core.QByteArray"}},
+			{"NewQTimeZone3", []string{"int"}},
+			{"NewQTimeZone4", []string{"*core.QByteArray", "int", "string", "string", "core.QLocale__Country", "string"}},
+			{"NewQTimeZone5", []string{"*core.QTimeZone"}},
+		},
+	},
+	"QToolBar": {
+		"QToolBar": {
+			{"NewQToolBar", []string{"string", "*widgets.QWidget"}},
+			{"NewQToolBar2", []string{"*widgets.QWidget"}},
+		},
+		"addAction": {
+			{"AddAction", []string{"string"}},
+			{"AddAction2", []string{"*gui.QIcon", "string"}},
+			{"AddAction3", []string{"string", "*core.QObject", "string"}},
+			{"AddAction4", []string{"*gui.QIcon", "string", "*core.QObject", "string"}},
+		},
+	},
+	"QToolBox": {
+		"QToolBox": {
+			{"NewQToolBox", []string{"*widgets.QWidget", "core.Qt__WindowType"}},
+		},
+		"addItem": {
+			{"AddItem", []string{"*widgets.QWidget", "*gui.QIcon", "string"}},
+			{"AddItem2", []string{"*widgets.QWidget", "string"}},
+		},
+		"insertItem": {
+			{"InsertItem", []string{"int", "*widgets.QWidget", "*gui.QIcon", "string"}},
+			{"InsertItem2", []string{"int", "*widgets.QWidget", "string"}},
+		},
+	},
+	"QToolButton": {
+		"QToolButton": {
+			{"NewQToolButton", []string{"*widgets.QWidget"}},
+		},
+	},
+	"QTransform": {
+		"QTransform": {
+			{"NewQTransform2", []string{}},
+			{"NewQTransform3", []string{"float64", "float64", "float64", "float64", "float64", "float64", "float64", "float64", "float64"}},
+			{"NewQTransform4", []string{"float64", "float64", "float64", "float64", "float64", "float64"}},
+			{"NewQTransform5", []string{"*gui.QMatrix"}},
+		},
+	},
+	"QTreeView": {
+		"QTreeView": {
+			{"NewQTreeView", []string{"*widgets.QWidget"}},
+		},
+	},
+	"QTreeWidget": {
+		"QTreeWidget": {
+			{"NewQTreeWidget", []string{"*widgets.QWidget"}},
+		},
+		"setCurrentItem": {
+			{"SetCurrentItem", []string{"*widgets.QTreeWidgetItem"}},
+			{"SetCurrentItem2", []string{"*widgets.QTreeWidgetItem", "int"}},
+			{"SetCurrentItem3", []string{"*widgets.QTreeWidgetItem", "int", "core.QItemSelectionModel__SelectionFlag"}},
+		},
+	},
+	"QTreeWidgetItem": {
+		"QTreeWidgetItem": {
+			{"NewQTreeWidgetItem", []string{"int"}},
+			{"NewQTreeWidgetItem2", []string{"[]string", "int"}},
+			{"NewQTreeWidgetItem3", []string{"*widgets.QTreeWidget", "int"}},
+			{"NewQTreeWidgetItem4", []string{"*widgets.QTreeWidget", "[]string", "int"}},
+			{"NewQTreeWidgetItem5", []string{"*widgets.QTreeWidget", "*widgets.QTreeWidgetItem", "int"}},
+			{"NewQTreeWidgetItem6", []string{"*widgets.QTreeWidgetItem", "int"}},
+			{"NewQTreeWidgetItem7", []string{"*widgets.QTreeWidgetItem", "[]string", "int"}},
+			{"NewQTreeWidgetItem8", []string{"*widgets.QTreeWidgetItem", "*widgets.QTreeWidgetItem", "int"}},
+			{"NewQTreeWidgetItem9", []string{"*widgets.QTreeWidgetItem"}},
+		},
+	},
+	"QUndoCommand": {
+		"QUndoCommand": {
+			{"NewQUndoCommand", []string{"*widgets.QUndoCommand"}},
+			{"NewQUndoCommand2", []string{"string", "*widgets.QUndoCommand"}},
+		},
+	},
+	"QUndoView": {
+		"QUndoView": {
+			{"NewQUndoView", []string{"*widgets.QWidget"}},
+			{"NewQUndoView2", []string{"*widgets.QUndoStack", "*widgets.QWidget"}},
+			{"NewQUndoView3", []string{"*widgets.QUndoGroup", "*widgets.QWidget"}},
+		},
+	},
+	"QUrl": {
+		"QUrl": {
+			{"NewQUrl", []string{}},
+			{"NewQUrl2", []string{"*core.QUrl"}},
+			{"NewQUrl3", []string{"string", "core.QUrl__ParsingMode"}},
+			{"NewQUrl4", []string{"*core.QUrl"}},
+		},
+		"setQuery": {
+			{"SetQuery", []string{"string", "core.QUrl__ParsingMode"}},
+			{"SetQuery2", []string{"*core.QUrlQuery"}},
+		},
+	},
+	"QUrlQuery": {
+		"QUrlQuery": {
+			{"NewQUrlQuery", []string{}},
+			{"NewQUrlQuery2", []string{"*core.QUrl"}},
+			{"NewQUrlQuery3", []string{"string"}},
+			{"NewQUrlQuery5", []string{"*core.QUrlQuery"}},
+		},
+	},
+	"QUuid": {
+		"QUuid": {
+			{"NewQUuid", []string{"*core.QByteArray"}},
+			{"NewQUuid2", []string{}},
+			{"NewQUuid3", []string{"uint", "uint16", "uint16", "string", "string", "string", "string", "string", "string", "string", "string"}},
+			{"NewQUuid4", []string{"string"}},
+		},
+	},
+	"QVBoxLayout": {
+		"QVBoxLayout": {
+			{"NewQVBoxLayout", []string{}},
+			{"NewQVBoxLayout2", []string{"*widgets.QWidget"}},
+		},
+	},
+	"QVariant": {
+		"QVariant": {
+			{"NewQVariant", []string{}},
+			{"NewQVariant1", []string{"interface{}"}},
+			{"NewQVariant2", []string{"core.QVariant__Type"}},
+			{"NewQVariant3", []string{"int", "unsafe.Pointer"}},
+			{"NewQVariant4", []string{"*core.QDataStream"}},
+			{"NewQVariant5", []string{"int"}},
+			{"NewQVariant6", []string{"uint"}},
+			{"NewQVariant7", []string{"int64"}},
+			{"NewQVariant8", []string{"uint64"}},
+			{"NewQVariant9", []string{"bool"}},
+			{"NewQVariant10", []string{"float64"}},
+			{"NewQVariant11", []string{"float32"}},
+			{"NewQVariant12", []string{"string"}},
+			{"NewQVariant13", []string{"*core.QByteArray"}},
+			{"NewQVariant14", []string{"*core.QBitArray"}},
+			{"NewQVariant15", []string{"string"}},
+			{"NewQVariant16", []string{"*core.QLatin1String"}},
+			{"NewQVariant17", []string{"[]string"}},
+			{"NewQVariant18", []string{"*core.QChar"}},
+			{"NewQVariant19", []string{"*core.QDate"}},
+			{"NewQVariant20", []string{"*core.QTime"}},
+			{"NewQVariant21", []string{"*core.QDateTime"}},
+			{"NewQVariant22", []string{"[]*core.QVariant"}},
+			{"NewQVariant23", []string{"map[string]*core.QVariant"}},
+			{"NewQVariant24", []string{"map[string]*core.QVariant"}},
+			{"NewQVariant25", []string{"*core.QSize"}},
+			{"NewQVariant26", []string{"*core.QSizeF"}},
+			{"NewQVariant27", []string{"*core.QPoint"}},
+			{"NewQVariant28", []string{"*core.QPointF"}},
+			{"NewQVariant29", []string{"*core.QLine"}},
+			{"NewQVariant30", []string{"*core.QLineF"}},
+			{"NewQVariant31", []string{"*core.QRect"}},
+			{"NewQVariant32", []string{"*core.QRectF"}},
+			{"NewQVariant33", []string{"*core.QLocale"}},
+			{"NewQVariant34", []string{"*core.QRegExp"}},
+			{"NewQVariant35", []string{"*core.QRegularExpression"}},
+			{"NewQVariant36", []string{"*core.QUrl"}},
+			{"NewQVariant37", []string{"*core.QEasingCurve"}},
+			{"NewQVariant38", []string{"*core.QUuid"}},
+			{"NewQVariant39", []string{"*core.QJsonValue"}},
+			{"NewQVariant40", []string{"*core.QJsonObject"}},
+			{"NewQVariant41", []string{"*core.QJsonArray"}},
+			{"NewQVariant42", []string{"*core.QJsonDocument"}},
+			{"NewQVariant43", []string{"*core.QModelIndex"}},
+			{"NewQVariant44", []string{"*core.QPersistentModelIndex"}},
+			{"NewQVariant45", []string{"*core.QVariant"}},
+		},
+	},
+	"QVersionNumber": {
+		"QVersionNumber": {
+			{"NewQVersionNumber", []string{}},
+			{"NewQVersionNumber2", []string{"[]int"}},
+			{"NewQVersionNumber3", []string{"[]int"}},
+			{"NewQVersionNumber5", []string{"int"}},
+			{"NewQVersionNumber6", []string{"int", "int"}},
+			{"NewQVersionNumber7", []string{"int", "int", "int"}},
+		},
+	},
+	"QWheelEvent": {
+		"QWheelEvent": {
+			{"NewQWheelEvent3", []string{"*core.QPointF", "*core.QPointF", "*core.QPoint", "*core.QPoint", "int", "core.Qt__Orientation", "core.Qt__MouseButton", "core.Qt__KeyboardModifier"}},
+			{"NewQWheelEvent4", []string{"*core.QPointF", "*core.QPointF", "*core.QPoint", "*core.QPoint", "int", "core.Qt__Orientation", "core.Qt__MouseButton", "core.Qt__KeyboardModifier", "core.Qt__ScrollPhase"}},
+			{"NewQWheelEvent5", []string{"*core.QPointF", "*core.QPointF", "*core.QPoint", "*core.QPoint", "int", "core.Qt__Orientation", "core.Qt__MouseButton", "core.Qt__KeyboardModifier", "core.Qt__ScrollPhase", "core.Qt__MouseEventSource"}},
+			{"NewQWheelEvent6", []string{"*core.QPointF", "*core.QPointF", "*core.QPoint", "*core.QPoint", "int", "core.Qt__Orientation", "core.Qt__MouseButton", "core.Qt__KeyboardModifier", "core.Qt__ScrollPhase", "core.Qt__MouseEventSource", "bool"}},
+			{"NewQWheelEvent7", []string{"*core.QPointF", "*core.QPointF", "*core.QPoint", "*core.QPoint", "core.Qt__MouseButton", "core.Qt__KeyboardModifier", "core.Qt__ScrollPhase", "bool", "core.Qt__MouseEventSource"}},
+		},
+	},
+	"QWidget": {
+		"QWidget": {
+			{"NewQWidget", []string{"*widgets.QWidget", "core.Qt__WindowType"}},
+		},
+		"setBaseSize": {
+			{"SetBaseSize", []string{"*core.QSize"}},
+			{"SetBaseSize2", []string{"int", "int"}},
+		},
+		"setContentsMargins": {
+			{"SetContentsMargins", []string{"int", "int", "int", "int"}},
+			{"SetContentsMargins2", []string{"*core.QMargins"}},
+		},
+		"setFixedSize": {
+			{"SetFixedSize", []string{"*core.QSize"}},
+			{"SetFixedSize2", []string{"int", "int"}},
+		},
+		"setFocus": {
+			{"SetFocus", []string{"core.Qt__FocusReason"}},
+			{"SetFocus2", []string{}},
+		},
+		"setGeometry": {
+			{"SetGeometry", []string{"*core.QRect"}},
+			{"SetGeometry2", []string{"int", "int", "int", "int"}},
+		},
+		"setMask": {
+			{"SetMask", []string{"*gui.QBitmap"}},
+			{"SetMask2", []string{"*gui.QRegion"}},
+		},
+		"setMaximumSize": {
+			{"SetMaximumSize", []string{"*core.QSize"}},
+			{"SetMaximumSize2", []string{"int", "int"}},
+		},
+		"setMinimumSize": {
+			{"SetMinimumSize", []string{"*core.QSize"}},
+			{"SetMinimumSize2", []string{"int", "int"}},
+		},
+		"setParent": {
+			{"SetParent", []string{"*widgets.QWidget"}},
+			{"SetParent2", []string{"*widgets.QWidget", "core.Qt__WindowType"}},
+		},
+		"setSizeIncrement": {
+			{"SetSizeIncrement", []string{"*core.QSize"}},
+			{"SetSizeIncrement2", []string{"int", "int"}},
+		},
+		"setSizePolicy": {
+			{"SetSizePolicy", []string{"*widgets.QSizePolicy"}},
+			{"SetSizePolicy2", []string{"widgets.QSizePolicy__Policy", "widgets.QSizePolicy__Policy"}},
+		},
+	},
+	"QWindow": {
+		"QWindow": {
+			{"NewQWindow", []string{"*gui.QScreen"}},
+			{"NewQWindow2", []string{"*gui.QWindow"}},
+		},
+		"setGeometry": {
+			{"SetGeometry", []string{"int", "int", "int", "int"}},
+			{"SetGeometry2", []string{"*core.QRect"}},
+		},
+		"setPosition": {
+			{"SetPosition", []string{"*core.QPoint"}},
+			{"SetPosition2", []string{"int", "int"}},
+		},
+	},
+	"QWizard": {
+		"QWizard": {
+			{"NewQWizard", []string{"*widgets.QWidget", "core.Qt__WindowType"}},
+		},
+	},
+	"QWizardPage": {
+		"QWizardPage": {
+			{"NewQWizardPage", []string{"*widgets.QWidget"}},
+		},
+	},
+	"QXmlStreamAttribute": {
+		"QXmlStreamAttribute": {
+			{"NewQXmlStreamAttribute", []string{}},
+			{"NewQXmlStreamAttribute2", []string{"string", "string"}},
+			{"NewQXmlStreamAttribute3", []string{"string", "string", "string"}},
+			{"NewQXmlStreamAttribute4", []string{"*core.QXmlStreamAttribute"}},
+		},
+	},
+	"QXmlStreamEntityDeclaration": {
+		"QXmlStreamEntityDeclaration": {
+			{"NewQXmlStreamEntityDeclaration", []string{}},
+			{"NewQXmlStreamEntityDeclaration2", []string{"*core.QXmlStreamEntityDeclaration"}},
+		},
+	},
+	"QXmlStreamNamespaceDeclaration": {
+		"QXmlStreamNamespaceDeclaration": {
+			{"NewQXmlStreamNamespaceDeclaration", []string{}},
+			{"NewQXmlStreamNamespaceDeclaration2", []string{"*core.QXmlStreamNamespaceDeclaration"}},
+			{"NewQXmlStreamNamespaceDeclaration4", []string{"string", "string"}},
+		},
+	},
+	"QXmlStreamNotationDeclaration": {
+		"QXmlStreamNotationDeclaration": {
+			{"NewQXmlStreamNotationDeclaration", []string{}},
+			{"NewQXmlStreamNotationDeclaration2", []string{"*core.QXmlStreamNotationDeclaration"}},
+		},
+	},
+	"QXmlStreamReader": {
+		"QXmlStreamReader": {
+			{"NewQXmlStreamReader", []string{}},
+			{"NewQXmlStreamReader2", []string{"*core.QIODevice"}},
+			{"NewQXmlStreamReader3", []string{"*core.QByteArray"}},
+			{"NewQXmlStreamReader4", []string{"string"}},
+			{"NewQXmlStreamReader5", []string{"string"}},
+		},
+		"addData": {
+			{"AddData", []string{"*core.QByteArray"}},
+			{"AddData2", []string{"string"}},
+			{"AddData3", []string{"string"}},
+		},
+	},
+	"QXmlStreamWriter": {
+		"QXmlStreamWriter": {
+			{"NewQXmlStreamWriter", []string{}},
+			{"NewQXmlStreamWriter2", []string{"*core.QIODevice"}},
+			{"NewQXmlStreamWriter3", []string{"*core.QByteArray"}},
+			{"NewQXmlStreamWriter4", []string{"string"}},
+		},
+		"setCodec": {
+			{"SetCodec", []string{"*core.QTextCodec"}},
+			{"SetCodec2", []string{"string"}},
+		},
+	},
+}
+
+// overload returns the therecipe name of the overload of method of class
+// taking arguments of the Go types args, method is the class name for
+// constructors. The overload is looked up in Overloads for class and its base
+// classes, exact matches are preferred.
+func (this *compiler) overload(pos Position, class string, method string, args ...string) string {
+	name := this.toCamelCase(method)
+	if method == class {
+		name = "New" + class
+	}
+
+	seen := map[string]bool{}
+	for c := class; c != "" && !seen[c]; c = this.superClass(c) {
+		seen[c] = true
+		overloads, ok := Overloads[c][method]
+		if !ok {
+			continue
+		}
+
+		for _, exact := range []bool{true, false} {
+			for _, overload := range overloads {
+				if this.takes(overload.Params, args, exact) {
+					return overload.Name
+				}
+			}
+		}
+
+		names := make([]string, len(overloads))
+		for i, overload := range overloads {
+			names[i] = fmt.Sprintf("%s(%s)", overload.Name, strings.Join(overload.Params, ", "))
+		}
+		this.errorf(pos, "no overload of %s::%s takes (%s), have %s", c, method,
+			strings.Join(args, ", "), strings.Join(names, ", "))
+		return name
+	}
+
+	// Constructors are not inherited, so the parameters of a binding class
+	// constructor missing from Overloads are unknown. Custom widgets are
+	// constructed by their own constructor.
+	if method == class && ClassPackages[class] != "" {
+		this.errorf(pos, "unknown constructor of %s", class)
+	}
+	return name
+}
+
+// constructor returns the qualified therecipe constructor of class taking
+// arguments of the Go types args, e.g. "core.NewQSize2".
+func (this *compiler) constructor(pos Position, class string, args ...string) string {
+	name := this.overload(pos, class, class, args...)
+	if _import := this.classPackage(class); _import != "" {
+		return this.qualify(_import, name)
+	}
+	return name
+}
+
+// setter returns the therecipe setter of the property name of class taking
+// arguments of the Go types args.
+func (this *compiler) setter(pos Position, class string, name string, args ...string) string {
+	return this.overload(pos, class, "set"+this.toCamelCase(name), args...)
+}
+
+// takes reports whether a function with the parameter types params can be
+// called with arguments of the types args. If exact is set the types must be
+// the same.
+func (this *compiler) takes(params []string, args []string, exact bool) bool {
+	if len(params) != len(args) {
+		return false
+	}
+	for i, arg := range args {
+		if arg == params[i] {
+			continue
+		}
+		if exact || !this.assignable(arg, params[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// assignable reports whether an argument of type arg can be passed as a
+// parameter of type param.
+func (this *compiler) assignable(arg string, param string) bool {
+	enum := strings.Contains(param, "__")
+	switch arg {
+	case untypedInt:
+		return enum || numericTypes[param]
+	case untypedFloat:
+		return param == "float32" || param == "float64"
+	case untypedNil:
+		return strings.HasPrefix(param, "*") || strings.HasPrefix(param, "[]")
+	case anyEnum:
+		return enum
+	}
+
+	// Pointers to a class can be passed as pointers to its bases.
+	if !strings.HasPrefix(arg, "*") || !strings.HasPrefix(param, "*") {
+		return false
+	}
+	return this.inherits(arg[strings.LastIndex(arg, ".")+1:], param[strings.LastIndex(param, ".")+1:])
+}
+
+// numericTypes are the Go types untyped integer constants can be passed as.
+var numericTypes = map[string]bool{
+	"int": true, "int8": true, "int16": true, "int32": true, "int64": true,
+	"uint": true, "uint8": true, "uint16": true, "uint32": true, "uint64": true,
+	"float32": true, "float64": true,
+}
@@ -0,0 +1,114 @@
+package parser
+
+import (
+	"bytes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"regexp"
+	"strings"
+)
+
+var _ = Describe("TestOverloads", func() {
+	It("matches the binding", func() {
+		for _, pkg := range bindingPackages {
+			source := bindingSource(pkg)
+
+			for class, methods := range Overloads {
+				if ClassPackages[class] != pkg {
+					continue
+				}
+				for method, overloads := range methods {
+					for _, overload := range overloads {
+						// The binding does not qualify the types of its own package and
+						// takes interfaces for pointers to classes.
+						params := make([]string, len(overload.Params))
+						for i, param := range overload.Params {
+							param = strings.Replace(param, pkg+".", "", 1)
+							if strings.HasPrefix(param, "*") && !strings.HasPrefix(param, "*[]") {
+								param = param[1:] + "_ITF"
+							}
+							params[i] = `\w+ ` + regexp.QuoteMeta(param)
+						}
+						if method == class {
+							Expect(source).To(MatchRegexp(`\nfunc `+overload.Name+`\(`+strings.Join(params, ", ")+`\)`), overload.Name)
+						} else {
+							Expect(source).To(MatchRegexp(`func \(ptr \*`+class+`\) `+overload.Name+`\(`+strings.Join(params, ", ")+`\)`), overload.Name)
+						}
+					}
+				}
+			}
+		}
+	})
+
+	It("has the constructors of the widgets and layouts", func() {
+		err, compiler := NewCompiler("testdata/custom.ui")
+		Expect(err).NotTo(HaveOccurred())
+
+		for class, pkg := range ClassPackages {
+			if !compiler.inherits(class, "QWidget") && !compiler.inherits(class, "QLayout") {
+				continue
+			}
+			if regexp.MustCompile(`\nfunc New` + class + `\d*\(`).MatchString(bindingSource(pkg)) {
+				Expect(Overloads[class]).To(HaveKey(class), class)
+			}
+		}
+	})
+
+	It("resolves overloads by argument types", func() {
+		err, compiler := NewCompiler("testdata/custom.ui")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(compiler.Parse()).To(Succeed())
+		buf := &bytes.Buffer{}
+		compiler.Diagnostics = buf
+
+		Expect(compiler.constructor(Position{}, "QSize", untypedInt, untypedInt)).To(Equal("core.NewQSize2"))
+		Expect(compiler.constructor(Position{}, "QSizeF", untypedFloat, untypedFloat)).To(Equal("core.NewQSizeF3"))
+		Expect(compiler.constructor(Position{}, "QPixmap", "string", "string", anyEnum)).To(Equal("gui.NewQPixmap3"))
+		Expect(compiler.constructor(Position{}, "QBrush", "*gui.QRadialGradient")).To(Equal("gui.NewQBrush10"))
+		Expect(compiler.constructor(Position{}, "QTreeWidgetItem", "*widgets.QTreeWidgetItem", untypedInt)).To(Equal("widgets.NewQTreeWidgetItem6"))
+		Expect(compiler.constructor(Position{}, "QToolBar", "*widgets.QWidget")).To(Equal("widgets.NewQToolBar2"))
+		Expect(compiler.constructor(Position{}, "QSpacerItem", untypedInt, untypedInt, anyEnum, anyEnum)).To(Equal("widgets.NewQSpacerItem"))
+		Expect(compiler.overload(Position{}, "QGridLayout", "addWidget", "*widgets.QPushButton",
+			untypedInt, untypedInt, untypedInt, untypedInt, untypedInt)).To(Equal("AddWidget3"))
+		Expect(compiler.setter(Position{}, "QPushButton", "minimumSize", untypedInt, untypedInt)).To(Equal("SetMinimumSize2"))
+		Expect(compiler.setter(Position{}, "ColorButton", "baseSize", "*core.QSize")).To(Equal("SetBaseSize"))
+		Expect(compiler.setter(Position{}, "QLabel", "text", "string")).To(Equal("SetText"))
+
+		Overloads["ColorButton"] = map[string][]*Overload{
+			"setColor": {{"SetColor", []string{"*gui.QColor"}}, {"SetColor2", []string{"string"}}},
+		}
+		defer delete(Overloads, "ColorButton")
+		Expect(compiler.setter(Position{}, "ColorButton", "color", "string")).To(Equal("SetColor2"))
+
+		Expect(buf.String()).To(BeEmpty())
+		Expect(compiler.setter(Position{File: "x.ui", Line: 3, Column: 5}, "QPushButton", "minimumSize", "string")).To(Equal("SetMinimumSize"))
+		Expect(buf.String()).To(Equal("x.ui:3:5: no overload of QWidget::setMinimumSize takes (string), have SetMinimumSize(*core.QSize), SetMinimumSize2(int, int)\n"))
+
+		buf.Reset()
+		Expect(compiler.constructor(Position{File: "x.ui", Line: 4, Column: 2}, "QTimer", "*core.QObject")).To(Equal("core.NewQTimer"))
+		Expect(compiler.constructor(Position{}, "ColorButton", "*widgets.QWidget")).To(Equal("NewColorButton"))
+		Expect(buf.String()).To(Equal("x.ui:4:2: unknown constructor of QTimer\n"))
+	})
+
+	It("generates the overloads", func() {
+		code, diagnostics := compile("testdata/overloads.ui", nil)
+
+		for _, line := range []string{
+			`Form.SetGeometry(core.NewQRect4(0, 0, 400, 300))`,
+			`Form.SetMinimumSize(core.NewQSize2(200, 100))`,
+			`this.Logo.SetPixmap(gui.NewQPixmap3("images/checked.png", "", core.Qt__AutoColor))`,
+			`this.GridLayout.AddWidget3(this.Logo, 0, 0, 1, 1, 0)`,
+			`this.View.SetSceneRect(core.NewQRectF4(0.500000, 0.000000, 100.000000, 50.500000))`,
+			`this.ButtonLayout = widgets.NewQHBoxLayout2(nil)`,
+			`treeItem1 = widgets.NewQTreeWidgetItem3(this.Tree, 0)`,
+			`treeItem2 = widgets.NewQTreeWidgetItem6(treeItem1, 0)`,
+			`treeItem2.SetIcon(0, icon)`,
+			`listItem = widgets.NewQListWidgetItem(nil, 0)`,
+			`this.List.AddItem2(listItem)`,
+			`this.GridLayout.AddLayout2(this.ButtonLayout, 1, 0, 1, 2, 0)`,
+		} {
+			Expect(code).To(ContainSubstring("\t" + line + "\n"))
+		}
+		Expect(diagnostics).To(BeEmpty())
+	})
+})
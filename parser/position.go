@@ -0,0 +1,81 @@
+package parser
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	xmlx "github.com/stephenlyu/go-pkg-xmlx"
+	"io"
+	"sort"
+	"unicode/utf8"
+)
+
+// Position is a location in a .ui file. Line and Column are 1-based, a zero
+// Line means the position is unknown.
+type Position struct {
+	File   string
+	Line   int
+	Column int
+}
+
+func (this Position) IsValid() bool {
+	return this.Line > 0
+}
+
+// String returns the position in the file:line:col form understood by
+// editors and CI annotations.
+func (this Position) String() string {
+	if !this.IsValid() {
+		return this.File
+	}
+	if this.File == "" {
+		return fmt.Sprintf("%d:%d", this.Line, this.Column)
+	}
+	return fmt.Sprintf("%s:%d:%d", this.File, this.Line, this.Column)
+}
+
+// scanPositions returns the position of every element node of doc. doc must
+// have been loaded from data, the elements are matched in document order.
+func scanPositions(file string, data []byte, doc *xmlx.Document) map[*xmlx.Node]Position {
+	lineStarts := []int{0}
+	for i, b := range data {
+		if b == '\n' {
+			lineStarts = append(lineStarts, i+1)
+		}
+	}
+
+	offsets := []int{}
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+	decoder.Entity = doc.Entity
+	for {
+		offset := int(decoder.InputOffset())
+		tok, err := decoder.Token()
+		if err != nil {
+			if err != io.EOF {
+				return nil
+			}
+			break
+		}
+		if _, ok := tok.(xml.StartElement); ok {
+			offsets = append(offsets, offset)
+		}
+	}
+
+	positions := make(map[*xmlx.Node]Position)
+	var walk func(n *xmlx.Node)
+	walk = func(n *xmlx.Node) {
+		if n.Type == xmlx.NT_ELEMENT && len(offsets) > 0 {
+			offset := offsets[0]
+			offsets = offsets[1:]
+			line := sort.SearchInts(lineStarts, offset+1)
+			// Columns count characters, like editors do, not bytes.
+			column := utf8.RuneCount(data[lineStarts[line-1]:offset]) + 1
+			positions[n] = Position{File: file, Line: line, Column: column}
+		}
+		for _, ch := range n.Children {
+			walk(ch)
+		}
+	}
+	walk(doc.Root)
+	return positions
+}
@@ -0,0 +1,955 @@
+package parser
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// PropertyKind tells how the generated code sets a property.
+type PropertyKind int
+
+const (
+	Settable     PropertyKind = iota // set with its setter, Set<Name>
+	ReadOnly                         // cannot be set
+	DesignerOnly                     // only meaningful to Designer, it is not set
+	Dynamic                          // set with QObject::setProperty
+)
+
+// Properties lists, per class, the properties that can be set in a .ui file
+// and how they are set. The table is maintained by hand: a property is
+// settable if the class has a Set<Name> method in the source of the binding's
+// package, read-only otherwise, and designer-only if Designer alone uses it.
+// TestProperties checks the settable and read-only properties against the
+// binding. Entries can be added for custom classes.
+var Properties = map[string]map[string]PropertyKind{
+	"QAbstractButton": {
+		"autoExclusive": Settable, "autoRepeat": Settable, "autoRepeatDelay": Settable,
+		"autoRepeatInterval": Settable, "checkable": Settable, "checked": Settable, "down": Settable,
+		"icon": Settable, "iconSize": Settable, "shortcut": Settable, "text": Settable,
+	},
+	"QAbstractGraphicsShapeItem": {
+		"brush": Settable, "pen": Settable,
+	},
+	"QAbstractItemDelegate": {
+		"editorData": Settable, "modelData": Settable,
+	},
+	"QAbstractItemView": {
+		"alternatingRowColors": Settable, "autoScroll": Settable, "autoScrollMargin": Settable,
+		"currentIndex": Settable, "defaultDropAction": Settable, "dirtyRegion": Settable,
+		"dragDropMode": Settable, "dragDropOverwriteMode": Settable, "dragEnabled": Settable,
+		"dropIndicatorShown": Settable, "editTriggers": Settable, "horizontalScrollMode": Settable,
+		"iconSize": Settable, "indexWidget": Settable, "itemDelegate": Settable,
+		"itemDelegateForColumn": Settable, "itemDelegateForRow": Settable, "model": Settable,
+		"rootIndex": Settable, "selection": Settable, "selectionBehavior": Settable,
+		"selectionMode": Settable, "selectionModel": Settable, "state": Settable,
+		"tabKeyNavigation": Settable, "textElideMode": Settable, "verticalScrollMode": Settable,
+	},
+	"QAbstractScrollArea": {
+		"cornerWidget": Settable, "horizontalScrollBar": Settable, "horizontalScrollBarPolicy": Settable,
+		"sizeAdjustPolicy": Settable, "verticalScrollBar": Settable, "verticalScrollBarPolicy": Settable,
+		"viewport": Settable, "viewportMargins": Settable,
+	},
+	"QAbstractSlider": {
+		"invertedAppearance": Settable, "invertedControls": Settable, "maximum": Settable,
+		"minimum": Settable, "orientation": Settable, "pageStep": Settable, "range": Settable,
+		"repeatAction": Settable, "singleStep": Settable, "sliderDown": Settable, "sliderPosition": Settable,
+		"tracking": Settable, "value": Settable,
+	},
+	"QAbstractSpinBox": {
+		"accelerated": Settable, "acceptableInput": ReadOnly, "alignment": Settable,
+		"buttonSymbols": Settable, "correctionMode": Settable, "frame": Settable,
+		"groupSeparatorShown": Settable, "keyboardTracking": Settable, "lineEdit": Settable,
+		"readOnly": Settable, "specialValueText": Settable, "text": ReadOnly, "wrapping": Settable,
+	},
+	"QAccessibleWidget": {
+		"text": Settable,
+	},
+	"QAction": {
+		"actionGroup": Settable, "autoRepeat": Settable, "checkable": Settable, "checked": Settable,
+		"data": Settable, "disabled": Settable, "enabled": Settable, "font": Settable, "icon": Settable,
+		"iconText": Settable, "iconVisibleInMenu": Settable, "menu": Settable, "menuRole": Settable,
+		"priority": Settable, "separator": Settable, "shortcut": Settable, "shortcutContext": Settable,
+		"shortcutVisibleInContextMenu": Settable, "shortcuts": Settable, "statusTip": Settable,
+		"text": Settable, "toolTip": Settable, "visible": Settable, "whatsThis": Settable,
+	},
+	"QActionGroup": {
+		"disabled": Settable, "enabled": Settable, "exclusive": Settable, "visible": Settable,
+	},
+	"QApplication": {
+		"activeWindow": Settable, "autoSipEnabled": Settable, "cursorFlashTime": Settable,
+		"doubleClickInterval": Settable, "effectEnabled": Settable, "font": Settable,
+		"globalStrut": Settable, "keyboardInputInterval": Settable, "navigationMode": Settable,
+		"palette": Settable, "startDragDistance": Settable, "startDragTime": Settable, "style": Settable,
+		"styleSheet": Settable, "wheelScrollLines": Settable, "windowIcon": Settable,
+	},
+	"QBoxLayout": {
+		"direction": Settable, "stretch": Settable, "stretchFactor": Settable,
+	},
+	"QButtonGroup": {
+		"exclusive": Settable, "id": Settable,
+	},
+	"QCalendarWidget": {
+		"currentPage": Settable, "dateEditAcceptDelay": Settable, "dateEditEnabled": Settable,
+		"dateRange": Settable, "dateTextFormat": Settable, "firstDayOfWeek": Settable,
+		"gridVisible": Settable, "headerTextFormat": Settable, "horizontalHeaderFormat": Settable,
+		"maximumDate": Settable, "minimumDate": Settable, "navigationBarVisible": Settable,
+		"selectedDate": Settable, "selectionMode": Settable, "verticalHeaderFormat": Settable,
+		"weekdayTextFormat": Settable,
+	},
+	"QCheckBox": {
+		"checkState": Settable, "tristate": Settable,
+	},
+	"QColorDialog": {
+		"currentColor": Settable, "customColor": Settable, "option": Settable, "options": Settable,
+		"standardColor": Settable,
+	},
+	"QColumnView": {
+		"columnWidths": Settable, "previewWidget": Settable, "resizeGripsVisible": Settable,
+		"rootIndex": Settable, "selection": Settable,
+	},
+	"QComboBox": {
+		"completer": Settable, "count": ReadOnly, "currentData": ReadOnly, "currentIndex": Settable,
+		"currentText": Settable, "duplicatesEnabled": Settable, "editText": Settable, "editable": Settable,
+		"frame": Settable, "iconSize": Settable, "insertPolicy": Settable, "itemData": Settable,
+		"itemDelegate": Settable, "itemIcon": Settable, "itemText": Settable, "lineEdit": Settable,
+		"maxCount": Settable, "maxVisibleItems": Settable, "minimumContentsLength": Settable,
+		"model": Settable, "modelColumn": Settable, "rootModelIndex": Settable, "sizeAdjustPolicy": Settable,
+		"validator": Settable, "view": Settable,
+	},
+	"QCommandLinkButton": {
+		"description": Settable,
+	},
+	"QCompleter": {
+		"caseSensitivity": Settable, "completionColumn": Settable, "completionMode": Settable,
+		"completionPrefix": Settable, "completionRole": Settable, "currentRow": Settable,
+		"filterMode": Settable, "maxVisibleItems": Settable, "model": Settable, "modelSorting": Settable,
+		"popup": Settable, "widget": Settable, "wrapAround": Settable,
+	},
+	"QDataWidgetMapper": {
+		"currentIndex": Settable, "currentModelIndex": Settable, "itemDelegate": Settable, "model": Settable,
+		"orientation": Settable, "rootIndex": Settable, "submitPolicy": Settable,
+	},
+	"QDateTimeEdit": {
+		"calendarPopup": Settable, "calendarWidget": Settable, "currentSection": Settable,
+		"currentSectionIndex": Settable, "date": Settable, "dateRange": Settable, "dateTime": Settable,
+		"dateTimeRange": Settable, "displayFormat": Settable, "maximumDate": Settable,
+		"maximumDateTime": Settable, "maximumTime": Settable, "minimumDate": Settable,
+		"minimumDateTime": Settable, "minimumTime": Settable, "sectionCount": ReadOnly,
+		"selectedSection": Settable, "time": Settable, "timeRange": Settable, "timeSpec": Settable,
+	},
+	"QDial": {
+		"notchTarget": Settable, "notchesVisible": Settable, "wrapping": Settable,
+	},
+	"QDialog": {
+		"modal": Settable, "result": Settable, "sizeGripEnabled": Settable, "visible": Settable,
+	},
+	"QDialogButtonBox": {
+		"centerButtons": Settable, "orientation": Settable, "standardButtons": Settable,
+	},
+	"QDirModel": {
+		"filter": Settable, "iconProvider": Settable, "lazyChildCount": Settable, "nameFilters": Settable,
+		"readOnly": Settable, "resolveSymlinks": Settable, "sorting": Settable,
+	},
+	"QDockWidget": {
+		"allowedAreas": Settable, "features": Settable, "floating": Settable, "titleBarWidget": Settable,
+		"widget": Settable,
+	},
+	"QDoubleSpinBox": {
+		"cleanText": ReadOnly, "decimals": Settable, "maximum": Settable, "minimum": Settable,
+		"prefix": Settable, "range": Settable, "singleStep": Settable, "stepType": Settable,
+		"suffix": Settable, "value": Settable,
+	},
+	"QFileDialog": {
+		"acceptMode": Settable, "confirmOverwrite": Settable, "defaultSuffix": Settable,
+		"directory": Settable, "directoryUrl": Settable, "fileMode": Settable, "filter": Settable,
+		"history": Settable, "iconProvider": Settable, "itemDelegate": Settable, "labelText": Settable,
+		"mimeTypeFilters": Settable, "nameFilter": Settable, "nameFilterDetailsVisible": Settable,
+		"nameFilters": Settable, "option": Settable, "options": Settable, "proxyModel": Settable,
+		"readOnly": Settable, "resolveSymlinks": Settable, "sidebarUrls": Settable,
+		"supportedSchemes": Settable, "viewMode": Settable,
+	},
+	"QFileIconProvider": {
+		"options": Settable,
+	},
+	"QFileSystemModel": {
+		"filter": Settable, "iconProvider": Settable, "nameFilterDisables": Settable,
+		"nameFilters": Settable, "readOnly": Settable, "resolveSymlinks": Settable, "rootPath": Settable,
+	},
+	"QFocusFrame": {
+		"widget": Settable,
+	},
+	"QFontComboBox": {
+		"currentFont": Settable, "fontFilters": Settable, "writingSystem": Settable,
+	},
+	"QFontDialog": {
+		"currentFont": Settable, "option": Settable, "options": Settable,
+	},
+	"QFormLayout": {
+		"fieldGrowthPolicy": Settable, "formAlignment": Settable, "horizontalSpacing": Settable,
+		"item": Settable, "labelAlignment": Settable, "layout": Settable, "rowWrapPolicy": Settable,
+		"verticalSpacing": Settable, "widget": Settable,
+	},
+	"QFrame": {
+		"frameRect": Settable, "frameShadow": Settable, "frameShape": Settable, "frameStyle": Settable,
+		"lineWidth": Settable, "midLineWidth": Settable,
+	},
+	"QGesture": {
+		"gestureCancelPolicy": Settable, "hotSpot": Settable,
+	},
+	"QGestureEvent": {
+		"accepted": Settable,
+	},
+	"QGraphicsAnchor": {
+		"sizePolicy": Settable, "spacing": Settable,
+	},
+	"QGraphicsAnchorLayout": {
+		"horizontalSpacing": Settable, "spacing": Settable, "verticalSpacing": Settable,
+	},
+	"QGraphicsBlurEffect": {
+		"blurHints": Settable, "blurRadius": Settable,
+	},
+	"QGraphicsColorizeEffect": {
+		"color": Settable, "strength": Settable,
+	},
+	"QGraphicsDropShadowEffect": {
+		"blurRadius": Settable, "color": Settable, "offset": Settable, "xOffset": Settable,
+		"yOffset": Settable,
+	},
+	"QGraphicsEffect": {
+		"enabled": Settable,
+	},
+	"QGraphicsEllipseItem": {
+		"rect": Settable, "spanAngle": Settable, "startAngle": Settable,
+	},
+	"QGraphicsGridLayout": {
+		"alignment": Settable, "columnAlignment": Settable, "columnFixedWidth": Settable,
+		"columnMaximumWidth": Settable, "columnMinimumWidth": Settable, "columnPreferredWidth": Settable,
+		"columnSpacing": Settable, "columnStretchFactor": Settable, "horizontalSpacing": Settable,
+		"rowAlignment": Settable, "rowFixedHeight": Settable, "rowMaximumHeight": Settable,
+		"rowMinimumHeight": Settable, "rowPreferredHeight": Settable, "rowSpacing": Settable,
+		"rowStretchFactor": Settable, "spacing": Settable, "verticalSpacing": Settable,
+	},
+	"QGraphicsItem": {
+		"acceptDrops": Settable, "acceptHoverEvents": Settable, "acceptTouchEvents": Settable,
+		"acceptedMouseButtons": Settable, "active": Settable, "boundingRegionGranularity": Settable,
+		"cacheMode": Settable, "cursor": Settable, "data": Settable, "enabled": Settable,
+		"filtersChildEvents": Settable, "flag": Settable, "flags": Settable, "focus": Settable,
+		"focusProxy": Settable, "graphicsEffect": Settable, "group": Settable, "inputMethodHints": Settable,
+		"opacity": Settable, "panelModality": Settable, "parentItem": Settable, "pos": Settable,
+		"rotation": Settable, "scale": Settable, "selected": Settable, "toolTip": Settable,
+		"transform": Settable, "transformOriginPoint": Settable, "transformations": Settable,
+		"visible": Settable, "x": Settable, "y": Settable, "zValue": Settable,
+	},
+	"QGraphicsItemAnimation": {
+		"item": Settable, "posAt": Settable, "rotationAt": Settable, "scaleAt": Settable,
+		"shearAt": Settable, "step": Settable, "timeLine": Settable, "translationAt": Settable,
+	},
+	"QGraphicsLayout": {
+		"contentsMargins": Settable,
+	},
+	"QGraphicsLayoutItem": {
+		"geometry": Settable, "graphicsItem": Settable, "maximumHeight": Settable, "maximumSize": Settable,
+		"maximumWidth": Settable, "minimumHeight": Settable, "minimumSize": Settable,
+		"minimumWidth": Settable, "ownedByLayout": Settable, "parentLayoutItem": Settable,
+		"preferredHeight": Settable, "preferredSize": Settable, "preferredWidth": Settable,
+		"sizePolicy": Settable,
+	},
+	"QGraphicsLineItem": {
+		"line": Settable, "pen": Settable,
+	},
+	"QGraphicsLinearLayout": {
+		"alignment": Settable, "itemSpacing": Settable, "orientation": Settable, "spacing": Settable,
+		"stretchFactor": Settable,
+	},
+	"QGraphicsObject": {
+		"enabled": Settable, "graphicsEffect": Settable, "opacity": Settable, "parent": Settable,
+		"pos": Settable, "rotation": Settable, "scale": Settable, "transformOriginPoint": Settable,
+		"visible": Settable, "x": Settable, "y": Settable, "zValue": Settable,
+	},
+	"QGraphicsOpacityEffect": {
+		"opacity": Settable, "opacityMask": Settable,
+	},
+	"QGraphicsPathItem": {
+		"path": Settable,
+	},
+	"QGraphicsPixmapItem": {
+		"offset": Settable, "pixmap": Settable, "shapeMode": Settable, "transformationMode": Settable,
+	},
+	"QGraphicsPolygonItem": {
+		"fillRule": Settable, "polygon": Settable,
+	},
+	"QGraphicsProxyWidget": {
+		"widget": Settable,
+	},
+	"QGraphicsRectItem": {
+		"rect": Settable,
+	},
+	"QGraphicsRotation": {
+		"angle": Settable, "axis": Settable, "origin": Settable,
+	},
+	"QGraphicsScale": {
+		"origin": Settable, "xScale": Settable, "yScale": Settable, "zScale": Settable,
+	},
+	"QGraphicsScene": {
+		"activePanel": Settable, "activeWindow": Settable, "backgroundBrush": Settable,
+		"bspTreeDepth": Settable, "focus": Settable, "focusItem": Settable, "focusOnTouch": Settable,
+		"font": Settable, "foregroundBrush": Settable, "itemIndexMethod": Settable,
+		"minimumRenderSize": Settable, "palette": Settable, "sceneRect": Settable, "selectionArea": Settable,
+		"sortCacheEnabled": Settable, "stickyFocus": Settable, "style": Settable,
+	},
+	"QGraphicsSceneDragDropEvent": {
+		"dropAction": Settable,
+	},
+	"QGraphicsSimpleTextItem": {
+		"font": Settable, "text": Settable,
+	},
+	"QGraphicsTextItem": {
+		"defaultTextColor": Settable, "document": Settable, "font": Settable, "html": Settable,
+		"openExternalLinks": Settable, "plainText": Settable, "tabChangesFocus": Settable,
+		"textCursor": Settable, "textInteractionFlags": Settable, "textWidth": Settable,
+	},
+	"QGraphicsView": {
+		"alignment": Settable, "backgroundBrush": Settable, "cacheMode": Settable, "dragMode": Settable,
+		"foregroundBrush": Settable, "interactive": Settable, "matrix": Settable,
+		"optimizationFlag": Settable, "optimizationFlags": Settable, "renderHint": Settable,
+		"renderHints": Settable, "resizeAnchor": Settable, "rubberBandSelectionMode": Settable,
+		"scene": Settable, "sceneRect": Settable, "transform": Settable, "transformationAnchor": Settable,
+		"viewportUpdateMode": Settable,
+	},
+	"QGraphicsWidget": {
+		"attribute": Settable, "autoFillBackground": Settable, "contentsMargins": Settable,
+		"focusPolicy": Settable, "font": Settable, "geometry": Settable, "layout": Settable,
+		"layoutDirection": Settable, "maximumSize": Settable, "minimumSize": Settable, "palette": Settable,
+		"preferredSize": Settable, "shortcutAutoRepeat": Settable, "shortcutEnabled": Settable,
+		"sizePolicy": Settable, "style": Settable, "tabOrder": Settable, "windowFlags": Settable,
+		"windowFrameMargins": Settable, "windowTitle": Settable,
+	},
+	"QGridLayout": {
+		"columnMinimumWidth": Settable, "columnStretch": Settable, "horizontalSpacing": Settable,
+		"originCorner": Settable, "rowMinimumHeight": Settable, "rowStretch": Settable,
+		"verticalSpacing": Settable,
+	},
+	"QGroupBox": {
+		"alignment": Settable, "checkable": Settable, "checked": Settable, "flat": Settable,
+		"title": Settable,
+	},
+	"QHeaderView": {
+		"cascadingSectionResizes": Settable, "defaultAlignment": Settable, "defaultSectionSize": Settable,
+		"firstSectionMovable": Settable, "highlightSections": Settable, "maximumSectionSize": Settable,
+		"minimumSectionSize": Settable, "offset": Settable, "offsetToSectionPosition": Settable,
+		"resizeContentsPrecision": Settable, "sectionHidden": Settable, "sectionResizeMode": Settable,
+		"sectionsClickable": Settable, "sectionsMovable": Settable, "selection": Settable,
+		"sortIndicator": Settable, "sortIndicatorShown": Settable, "stretchLastSection": Settable,
+		"visible": Settable,
+	},
+	"QInputDialog": {
+		"cancelButtonText": Settable, "comboBoxEditable": Settable, "comboBoxItems": Settable,
+		"doubleDecimals": Settable, "doubleMaximum": Settable, "doubleMinimum": Settable,
+		"doubleRange": Settable, "doubleStep": Settable, "doubleValue": Settable, "inputMode": Settable,
+		"intMaximum": Settable, "intMinimum": Settable, "intRange": Settable, "intStep": Settable,
+		"intValue": Settable, "labelText": Settable, "okButtonText": Settable, "option": Settable,
+		"options": Settable, "textEchoMode": Settable, "textValue": Settable,
+	},
+	"QItemDelegate": {
+		"clipping": Settable, "itemEditorFactory": Settable,
+	},
+	"QItemEditorFactory": {
+		"defaultFactory": Settable,
+	},
+	"QKeyEventTransition": {
+		"key": Settable, "modifierMask": Settable,
+	},
+	"QKeySequenceEdit": {
+		"keySequence": Settable,
+	},
+	"QLCDNumber": {
+		"digitCount": Settable, "mode": Settable, "segmentStyle": Settable, "smallDecimalPoint": Settable,
+	},
+	"QLabel": {
+		"alignment": Settable, "buddy": Settable, "hasSelectedText": ReadOnly, "indent": Settable,
+		"margin": Settable, "movie": Settable, "num": Settable, "openExternalLinks": Settable,
+		"picture": Settable, "pixmap": Settable, "scaledContents": Settable, "selectedText": ReadOnly,
+		"selection": Settable, "text": Settable, "textFormat": Settable, "textInteractionFlags": Settable,
+		"wordWrap": Settable,
+	},
+	"QLayout": {
+		"alignment": Settable, "bottomMargin": DesignerOnly, "contentsMargins": Settable,
+		"enabled": Settable, "geometry": Settable, "leftMargin": DesignerOnly, "margin": DesignerOnly,
+		"menuBar": Settable, "rightMargin": DesignerOnly, "sizeConstraint": Settable, "spacing": Settable,
+		"topMargin": DesignerOnly,
+	},
+	"QLayoutItem": {
+		"alignment": Settable, "geometry": Settable,
+	},
+	"QLineEdit": {
+		"acceptableInput": ReadOnly, "alignment": Settable, "clearButtonEnabled": Settable,
+		"completer": Settable, "cursorMoveStyle": Settable, "cursorPosition": Settable,
+		"displayText": ReadOnly, "dragEnabled": Settable, "echoMode": Settable, "frame": Settable,
+		"hasSelectedText": ReadOnly, "inputMask": Settable, "maxLength": Settable, "modified": Settable,
+		"placeholderText": Settable, "readOnly": Settable, "redoAvailable": ReadOnly,
+		"selectedText": ReadOnly, "selection": Settable, "text": Settable, "textMargins": Settable,
+		"undoAvailable": ReadOnly, "validator": Settable,
+	},
+	"QListView": {
+		"batchSize": Settable, "flow": Settable, "gridSize": Settable, "itemAlignment": Settable,
+		"layoutMode": Settable, "modelColumn": Settable, "movement": Settable, "positionForIndex": Settable,
+		"resizeMode": Settable, "rowHidden": Settable, "selection": Settable,
+		"selectionRectVisible": Settable, "spacing": Settable, "uniformItemSizes": Settable,
+		"viewMode": Settable, "wordWrap": Settable, "wrapping": Settable,
+	},
+	"QListWidget": {
+		"count": ReadOnly, "currentItem": Settable, "currentRow": Settable, "itemWidget": Settable,
+		"sortingEnabled": Settable,
+	},
+	"QListWidgetItem": {
+		"background": Settable, "checkState": Settable, "data": Settable, "flags": Settable,
+		"font": Settable, "foreground": Settable, "hidden": Settable, "icon": Settable, "selected": Settable,
+		"sizeHint": Settable, "statusTip": Settable, "text": Settable, "textAlignment": Settable,
+		"toolTip": Settable, "whatsThis": Settable,
+	},
+	"QMainWindow": {
+		"animated": Settable, "centralWidget": Settable, "corner": Settable, "dockNestingEnabled": Settable,
+		"dockOptions": Settable, "documentMode": Settable, "iconSize": Settable, "menuBar": Settable,
+		"menuWidget": Settable, "statusBar": Settable, "tabPosition": Settable, "tabShape": Settable,
+		"toolButtonStyle": Settable, "unifiedTitleAndToolBarOnMac": Settable,
+	},
+	"QMdiArea": {
+		"activationOrder": Settable, "activeSubWindow": Settable, "activeSubWindowName": DesignerOnly,
+		"activeSubWindowTitle": DesignerOnly, "background": Settable, "documentMode": Settable,
+		"option": Settable, "tabPosition": Settable, "tabShape": Settable, "tabsClosable": Settable,
+		"tabsMovable": Settable, "viewMode": Settable,
+	},
+	"QMdiSubWindow": {
+		"keyboardPageStep": Settable, "keyboardSingleStep": Settable, "option": Settable,
+		"systemMenu": Settable, "widget": Settable,
+	},
+	"QMenu": {
+		"activeAction": Settable, "defaultAction": Settable, "icon": Settable,
+		"separatorsCollapsible": Settable, "tearOffEnabled": Settable, "title": Settable,
+		"toolTipsVisible": Settable,
+	},
+	"QMenuBar": {
+		"activeAction": Settable, "cornerWidget": Settable, "defaultUp": Settable, "nativeMenuBar": Settable,
+	},
+	"QMessageBox": {
+		"checkBox": Settable, "defaultButton": Settable, "detailedText": Settable, "escapeButton": Settable,
+		"icon": Settable, "iconPixmap": Settable, "informativeText": Settable, "standardButtons": Settable,
+		"text": Settable, "textFormat": Settable, "textInteractionFlags": Settable, "windowTitle": Settable,
+	},
+	"QMouseEventTransition": {
+		"button": Settable, "hitTestPath": Settable, "modifierMask": Settable,
+	},
+	"QObject": {
+		"objectName": Settable, "parent": Settable, "property": Settable,
+	},
+	"QOpenGLWidget": {
+		"format": Settable, "textureFormat": Settable, "updateBehavior": Settable,
+	},
+	"QPanGesture": {
+		"acceleration": Settable, "lastOffset": Settable, "offset": Settable,
+	},
+	"QPinchGesture": {
+		"centerPoint": Settable, "changeFlags": Settable, "lastCenterPoint": Settable,
+		"lastRotationAngle": Settable, "lastScaleFactor": Settable, "rotationAngle": Settable,
+		"scaleFactor": Settable, "startCenterPoint": Settable, "totalChangeFlags": Settable,
+		"totalRotationAngle": Settable, "totalScaleFactor": Settable,
+	},
+	"QPlainTextDocumentLayout": {
+		"cursorWidth": Settable,
+	},
+	"QPlainTextEdit": {
+		"backgroundVisible": Settable, "centerOnScroll": Settable, "currentCharFormat": Settable,
+		"cursorWidth": Settable, "document": Settable, "documentTitle": Settable, "lineWrapMode": Settable,
+		"maximumBlockCount": Settable, "overwriteMode": Settable, "placeholderText": Settable,
+		"plainText": Settable, "readOnly": Settable, "tabChangesFocus": Settable,
+		"tabStopDistance": Settable, "tabStopWidth": Settable, "textCursor": Settable,
+		"textInteractionFlags": Settable, "undoRedoEnabled": Settable, "wordWrapMode": Settable,
+	},
+	"QProgressBar": {
+		"alignment": Settable, "format": Settable, "invertedAppearance": Settable, "maximum": Settable,
+		"minimum": Settable, "orientation": Settable, "range": Settable, "text": ReadOnly,
+		"textDirection": Settable, "textVisible": Settable, "value": Settable,
+	},
+	"QProgressDialog": {
+		"autoClose": Settable, "autoReset": Settable, "bar": Settable, "cancelButton": Settable,
+		"cancelButtonText": Settable, "label": Settable, "labelText": Settable, "maximum": Settable,
+		"minimum": Settable, "minimumDuration": Settable, "range": Settable, "value": Settable,
+	},
+	"QProxyStyle": {
+		"baseStyle": Settable,
+	},
+	"QPushButton": {
+		"flat": Settable, "menu": Settable,
+	},
+	"QScrollArea": {
+		"alignment": Settable, "widget": Settable, "widgetResizable": Settable,
+	},
+	"QScroller": {
+		"scrollerProperties": Settable, "snapPositionsX": Settable, "snapPositionsY": Settable,
+	},
+	"QScrollerProperties": {
+		"defaultScrollerProperties": Settable, "scrollMetric": Settable,
+	},
+	"QShortcut": {
+		"autoRepeat": Settable, "context": Settable, "enabled": Settable, "key": Settable,
+		"whatsThis": Settable,
+	},
+	"QSizeGrip": {
+		"visible": Settable,
+	},
+	"QSizePolicy": {
+		"controlType": Settable, "heightForWidth": Settable, "horizontalPolicy": Settable,
+		"horizontalStretch": Settable, "retainSizeWhenHidden": Settable, "verticalPolicy": Settable,
+		"verticalStretch": Settable, "widthForHeight": Settable,
+	},
+	"QSlider": {
+		"tickInterval": Settable, "tickPosition": Settable,
+	},
+	"QSpacerItem": {
+		"geometry": Settable,
+	},
+	"QSpinBox": {
+		"cleanText": ReadOnly, "displayIntegerBase": Settable, "maximum": Settable, "minimum": Settable,
+		"prefix": Settable, "range": Settable, "singleStep": Settable, "stepType": Settable,
+		"suffix": Settable, "value": Settable,
+	},
+	"QSplashScreen": {
+		"pixmap": Settable,
+	},
+	"QSplitter": {
+		"childrenCollapsible": Settable, "collapsible": Settable, "count": ReadOnly, "handleWidth": Settable,
+		"opaqueResize": Settable, "orientation": Settable, "rubberBand": Settable, "sizes": Settable,
+		"stretchFactor": Settable,
+	},
+	"QSplitterHandle": {
+		"orientation": Settable,
+	},
+	"QStackedLayout": {
+		"currentIndex": Settable, "currentWidget": Settable, "stackingMode": Settable,
+	},
+	"QStackedWidget": {
+		"count": ReadOnly, "currentIndex": Settable, "currentPageName": DesignerOnly,
+		"currentWidget": Settable,
+	},
+	"QStatusBar": {
+		"sizeGripEnabled": Settable,
+	},
+	"QStyleHintReturn": {
+		"type": Settable, "version": Settable,
+	},
+	"QStyleHintReturnMask": {
+		"region": Settable,
+	},
+	"QStyleHintReturnVariant": {
+		"variant": Settable,
+	},
+	"QStyleOption": {
+		"direction": Settable, "fontMetrics": Settable, "palette": Settable, "rect": Settable,
+		"state": Settable, "styleObject": Settable, "type": Settable, "version": Settable,
+	},
+	"QStyleOptionButton": {
+		"features": Settable, "icon": Settable, "iconSize": Settable, "text": Settable,
+	},
+	"QStyleOptionComboBox": {
+		"currentIcon": Settable, "currentText": Settable, "editable": Settable, "frame": Settable,
+		"iconSize": Settable, "popupRect": Settable,
+	},
+	"QStyleOptionComplex": {
+		"activeSubControls": Settable, "subControls": Settable,
+	},
+	"QStyleOptionDockWidget": {
+		"closable": Settable, "floatable": Settable, "movable": Settable, "title": Settable,
+	},
+	"QStyleOptionFocusRect": {
+		"backgroundColor": Settable,
+	},
+	"QStyleOptionFrame": {
+		"features": Settable, "frameShape": Settable, "lineWidth": Settable, "midLineWidth": Settable,
+	},
+	"QStyleOptionGraphicsItem": {
+		"exposedRect": Settable,
+	},
+	"QStyleOptionGroupBox": {
+		"features": Settable, "lineWidth": Settable, "midLineWidth": Settable, "text": Settable,
+		"textAlignment": Settable, "textColor": Settable,
+	},
+	"QStyleOptionHeader": {
+		"icon": Settable, "iconAlignment": Settable, "orientation": Settable, "position": Settable,
+		"section": Settable, "selectedPosition": Settable, "sortIndicator": Settable, "text": Settable,
+		"textAlignment": Settable,
+	},
+	"QStyleOptionMenuItem": {
+		"checkType": Settable, "checked": Settable, "font": Settable, "icon": Settable,
+		"maxIconWidth": Settable, "menuHasCheckableItems": Settable, "menuItemType": Settable,
+		"menuRect": Settable, "tabWidth": Settable, "text": Settable,
+	},
+	"QStyleOptionProgressBar": {
+		"bottomToTop": Settable, "invertedAppearance": Settable, "maximum": Settable, "minimum": Settable,
+		"progress": Settable, "text": Settable, "textAlignment": Settable, "textVisible": Settable,
+	},
+	"QStyleOptionRubberBand": {
+		"opaque": Settable, "shape": Settable,
+	},
+	"QStyleOptionSizeGrip": {
+		"corner": Settable,
+	},
+	"QStyleOptionSlider": {
+		"dialWrapping": Settable, "maximum": Settable, "minimum": Settable, "notchTarget": Settable,
+		"orientation": Settable, "pageStep": Settable, "singleStep": Settable, "sliderPosition": Settable,
+		"sliderValue": Settable, "tickInterval": Settable, "tickPosition": Settable, "upsideDown": Settable,
+	},
+	"QStyleOptionSpinBox": {
+		"buttonSymbols": Settable, "frame": Settable, "stepEnabled": Settable,
+	},
+	"QStyleOptionTab": {
+		"cornerWidgets": Settable, "documentMode": Settable, "icon": Settable, "iconSize": Settable,
+		"leftButtonSize": Settable, "position": Settable, "rightButtonSize": Settable, "row": Settable,
+		"selectedPosition": Settable, "shape": Settable, "text": Settable,
+	},
+	"QStyleOptionTabBarBase": {
+		"documentMode": Settable, "selectedTabRect": Settable, "shape": Settable, "tabBarRect": Settable,
+	},
+	"QStyleOptionTabWidgetFrame": {
+		"leftCornerWidgetSize": Settable, "lineWidth": Settable, "midLineWidth": Settable,
+		"rightCornerWidgetSize": Settable, "selectedTabRect": Settable, "shape": Settable,
+		"tabBarRect": Settable, "tabBarSize": Settable,
+	},
+	"QStyleOptionTitleBar": {
+		"icon": Settable, "text": Settable, "titleBarFlags": Settable, "titleBarState": Settable,
+	},
+	"QStyleOptionToolBar": {
+		"features": Settable, "lineWidth": Settable, "midLineWidth": Settable, "positionOfLine": Settable,
+		"positionWithinLine": Settable, "toolBarArea": Settable,
+	},
+	"QStyleOptionToolBox": {
+		"icon": Settable, "selectedPosition": Settable, "text": Settable,
+	},
+	"QStyleOptionToolButton": {
+		"arrowType": Settable, "features": Settable, "font": Settable, "icon": Settable,
+		"iconSize": Settable, "pos": Settable, "text": Settable, "toolButtonStyle": Settable,
+	},
+	"QStyleOptionViewItem": {
+		"backgroundBrush": Settable, "checkState": Settable, "decorationAlignment": Settable,
+		"decorationPosition": Settable, "decorationSize": Settable, "displayAlignment": Settable,
+		"features": Settable, "font": Settable, "icon": Settable, "index": Settable,
+		"showDecorationSelected": Settable, "text": Settable, "textElideMode": Settable,
+		"viewItemPosition": Settable,
+	},
+	"QStyledItemDelegate": {
+		"itemEditorFactory": Settable,
+	},
+	"QSwipeGesture": {
+		"swipeAngle": Settable,
+	},
+	"QSystemTrayIcon": {
+		"contextMenu": Settable, "icon": Settable, "toolTip": Settable, "visible": Settable,
+	},
+	"QTabBar": {
+		"accessibleTabName": Settable, "autoHide": Settable, "changeCurrentOnDrag": Settable,
+		"count": ReadOnly, "currentIndex": Settable, "documentMode": Settable, "drawBase": Settable,
+		"elideMode": Settable, "expanding": Settable, "iconSize": Settable, "movable": Settable,
+		"selectionBehaviorOnRemove": Settable, "shape": Settable, "tabButton": Settable, "tabData": Settable,
+		"tabEnabled": Settable, "tabIcon": Settable, "tabText": Settable, "tabTextColor": Settable,
+		"tabToolTip": Settable, "tabWhatsThis": Settable, "tabsClosable": Settable,
+		"usesScrollButtons": Settable,
+	},
+	"QTabWidget": {
+		"cornerWidget": Settable, "count": ReadOnly, "currentIndex": Settable,
+		"currentTabIcon": DesignerOnly, "currentTabName": DesignerOnly, "currentTabText": DesignerOnly,
+		"currentTabToolTip": DesignerOnly, "currentTabWhatsThis": DesignerOnly, "currentWidget": Settable,
+		"documentMode": Settable, "elideMode": Settable, "iconSize": Settable, "movable": Settable,
+		"tabBar": Settable, "tabBarAutoHide": Settable, "tabEnabled": Settable, "tabIcon": Settable,
+		"tabPosition": Settable, "tabShape": Settable, "tabText": Settable, "tabToolTip": Settable,
+		"tabWhatsThis": Settable, "tabsClosable": Settable, "usesScrollButtons": Settable,
+	},
+	"QTableView": {
+		"columnHidden": Settable, "columnWidth": Settable, "cornerButtonEnabled": Settable,
+		"gridStyle": Settable, "horizontalHeader": Settable, "rootIndex": Settable, "rowHeight": Settable,
+		"rowHidden": Settable, "selection": Settable, "showGrid": Settable, "sortingEnabled": Settable,
+		"span": Settable, "verticalHeader": Settable, "wordWrap": Settable,
+	},
+	"QTableWidget": {
+		"cellWidget": Settable, "columnCount": Settable, "currentCell": Settable, "currentItem": Settable,
+		"horizontalHeaderItem": Settable, "horizontalHeaderLabels": Settable, "item": Settable,
+		"itemPrototype": Settable, "rangeSelected": Settable, "rowCount": Settable,
+		"verticalHeaderItem": Settable, "verticalHeaderLabels": Settable,
+	},
+	"QTableWidgetItem": {
+		"background": Settable, "checkState": Settable, "data": Settable, "flags": Settable,
+		"font": Settable, "foreground": Settable, "icon": Settable, "selected": Settable,
+		"sizeHint": Settable, "statusTip": Settable, "text": Settable, "textAlignment": Settable,
+		"toolTip": Settable, "whatsThis": Settable,
+	},
+	"QTapAndHoldGesture": {
+		"position": Settable, "timeout": Settable,
+	},
+	"QTapGesture": {
+		"position": Settable,
+	},
+	"QTextBrowser": {
+		"openExternalLinks": Settable, "openLinks": Settable, "readOnly": Settable, "searchPaths": Settable,
+		"source": Settable, "undoRedoEnabled": Settable,
+	},
+	"QTextEdit": {
+		"acceptRichText": Settable, "alignment": Settable, "autoFormatting": Settable,
+		"currentCharFormat": Settable, "currentFont": Settable, "cursorWidth": Settable,
+		"document": Settable, "documentTitle": Settable, "fontFamily": Settable, "fontItalic": Settable,
+		"fontPointSize": Settable, "fontUnderline": Settable, "fontWeight": Settable, "html": Settable,
+		"lineWrapColumnOrWidth": Settable, "lineWrapMode": Settable, "overwriteMode": Settable,
+		"placeholderText": Settable, "plainText": Settable, "readOnly": Settable,
+		"tabChangesFocus": Settable, "tabStopDistance": Settable, "tabStopWidth": Settable, "text": Settable,
+		"textBackgroundColor": Settable, "textColor": Settable, "textCursor": Settable,
+		"textInteractionFlags": Settable, "undoRedoEnabled": Settable, "wordWrapMode": Settable,
+	},
+	"QToolBar": {
+		"allowedAreas": Settable, "floatable": Settable, "iconSize": Settable, "movable": Settable,
+		"orientation": Settable, "toolButtonStyle": Settable,
+	},
+	"QToolBox": {
+		"count": ReadOnly, "currentIndex": Settable, "currentItemIcon": DesignerOnly,
+		"currentItemName": DesignerOnly, "currentItemText": DesignerOnly, "currentItemToolTip": DesignerOnly,
+		"currentWidget": Settable, "itemEnabled": Settable, "itemIcon": Settable, "itemText": Settable,
+		"itemToolTip": Settable,
+	},
+	"QToolButton": {
+		"arrowType": Settable, "autoRaise": Settable, "defaultAction": Settable, "menu": Settable,
+		"popupMode": Settable, "toolButtonStyle": Settable,
+	},
+	"QToolTip": {
+		"font": Settable, "palette": Settable,
+	},
+	"QTreeView": {
+		"allColumnsShowFocus": Settable, "animated": Settable, "autoExpandDelay": Settable,
+		"columnHidden": Settable, "columnWidth": Settable, "expanded": Settable,
+		"expandsOnDoubleClick": Settable, "firstColumnSpanned": Settable, "header": Settable,
+		"headerHidden": Settable, "indentation": Settable, "itemsExpandable": Settable,
+		"rootIndex": Settable, "rootIsDecorated": Settable, "rowHidden": Settable, "selection": Settable,
+		"sortingEnabled": Settable, "treePosition": Settable, "uniformRowHeights": Settable,
+		"wordWrap": Settable,
+	},
+	"QTreeWidget": {
+		"columnCount": Settable, "currentItem": Settable, "headerItem": Settable, "headerLabel": Settable,
+		"headerLabels": Settable, "itemWidget": Settable, "topLevelItemCount": ReadOnly,
+	},
+	"QTreeWidgetItem": {
+		"background": Settable, "checkState": Settable, "childIndicatorPolicy": Settable, "data": Settable,
+		"disabled": Settable, "expanded": Settable, "firstColumnSpanned": Settable, "flags": Settable,
+		"font": Settable, "foreground": Settable, "hidden": Settable, "icon": Settable, "selected": Settable,
+		"sizeHint": Settable, "statusTip": Settable, "text": Settable, "textAlignment": Settable,
+		"toolTip": Settable, "whatsThis": Settable,
+	},
+	"QUndoCommand": {
+		"obsolete": Settable, "text": Settable,
+	},
+	"QUndoGroup": {
+		"activeStack": Settable,
+	},
+	"QUndoStack": {
+		"active": Settable, "index": Settable, "undoLimit": Settable,
+	},
+	"QUndoView": {
+		"cleanIcon": Settable, "emptyLabel": Settable, "group": Settable, "stack": Settable,
+	},
+	"QWidget": {
+		"acceptDrops": Settable, "accessibleDescription": Settable, "accessibleName": Settable,
+		"attribute": Settable, "autoFillBackground": Settable, "backgroundRole": Settable,
+		"baseSize": Settable, "childrenRect": ReadOnly, "childrenRegion": ReadOnly,
+		"contentsMargins": Settable, "contextMenuPolicy": Settable, "cursor": Settable, "disabled": Settable,
+		"editFocus": Settable, "enabled": Settable, "fixedHeight": Settable, "fixedSize": Settable,
+		"fixedWidth": Settable, "focus": Settable, "focusPolicy": Settable, "focusProxy": Settable,
+		"font": Settable, "foregroundRole": Settable, "frameGeometry": ReadOnly, "frameSize": ReadOnly,
+		"fullScreen": ReadOnly, "geometry": Settable, "graphicsEffect": Settable, "hidden": Settable,
+		"inputMethodHints": Settable, "isActiveWindow": ReadOnly, "layout": Settable,
+		"layoutDirection": Settable, "locale": Settable, "mask": Settable, "maximized": ReadOnly,
+		"maximumHeight": Settable, "maximumSize": Settable, "maximumWidth": Settable, "minimized": ReadOnly,
+		"minimumHeight": Settable, "minimumSize": Settable, "minimumSizeHint": ReadOnly,
+		"minimumWidth": Settable, "modal": ReadOnly, "mouseTracking": Settable, "normalGeometry": ReadOnly,
+		"palette": Settable, "parent": Settable, "rect": ReadOnly, "shortcutAutoRepeat": Settable,
+		"shortcutEnabled": Settable, "sizeHint": ReadOnly, "sizeIncrement": Settable, "sizePolicy": Settable,
+		"statusTip": Settable, "style": Settable, "styleSheet": Settable, "tabOrder": Settable,
+		"tabletTracking": Settable, "toolTip": Settable, "toolTipDuration": Settable,
+		"updatesEnabled": Settable, "visible": Settable, "whatsThis": Settable, "windowFilePath": Settable,
+		"windowFlag": Settable, "windowFlags": Settable, "windowIcon": Settable, "windowIconText": Settable,
+		"windowModality": Settable, "windowModified": Settable, "windowOpacity": Settable,
+		"windowRole": Settable, "windowState": Settable, "windowTitle": Settable, "x": ReadOnly,
+		"y": ReadOnly,
+	},
+	"QWidgetAction": {
+		"defaultWidget": Settable,
+	},
+	"QWidgetItem": {
+		"geometry": Settable,
+	},
+	"QWizard": {
+		"button": Settable, "buttonText": Settable, "defaultProperty": Settable, "field": Settable,
+		"option": Settable, "options": Settable, "page": Settable, "pixmap": Settable,
+		"sideWidget": Settable, "startId": Settable, "subTitleFormat": Settable, "titleFormat": Settable,
+		"wizardStyle": Settable,
+	},
+	"QWizardPage": {
+		"buttonText": Settable, "commitPage": Settable, "field": Settable, "finalPage": Settable,
+		"pixmap": Settable, "subTitle": Settable, "title": Settable,
+	},
+}
+
+// propertyKind returns how prop of an object of class is set, ok is false if
+// class has no such property. Properties without standard setter are
+// dynamic. Unknown properties of classes outside the binding without entries,
+// like most custom widgets, are assumed to be settable.
+func (this *compiler) propertyKind(class string, prop *Property) (kind PropertyKind, ok bool) {
+	if !prop.StdSet {
+		return Dynamic, true
+	}
+
+	complete := true
+	seen := map[string]bool{}
+	for c := class; c != "" && !seen[c]; c = this.superClass(c) {
+		seen[c] = true
+		if kind, ok := Properties[c][prop.Name]; ok {
+			return kind, true
+		}
+		if _, ok := Properties[c]; !ok && this.classPackage(c) == "" {
+			complete = false
+		}
+	}
+	return Settable, !complete
+}
+
+// inherits reports whether class is base or derives from it.
+func (this *compiler) inherits(class string, base string) bool {
+	seen := map[string]bool{}
+	for c := class; c != "" && !seen[c]; c = this.superClass(c) {
+		seen[c] = true
+		if c == base {
+			return true
+		}
+	}
+	return false
+}
+
+// setDynamicProperty sets prop of the object name of class with
+// QObject::setProperty.
+func (this *compiler) setDynamicProperty(class string, name string, prop *Property) {
+	if !this.inherits(class, "QObject") {
+		this.errorf(prop.Pos, "cannot set dynamic property %s, %s is not a QObject", prop.Name, class)
+		return
+	}
+
+	// variant is the QVariant expression, or value of valueType is wrapped
+	// in one.
+	var variant, value, valueType string
+	translate := false
+	switch v := prop.Value.(type) {
+	case bool:
+		value, valueType = boolToString(v), "bool"
+	case int:
+		value, valueType = strconv.Itoa(v), "int"
+	case int64:
+		value, valueType = strconv.FormatInt(v, 10), "int64"
+	case uint64:
+		value, valueType = strconv.FormatUint(v, 10), "uint64"
+	case float32:
+		value, valueType = fmt.Sprintf("%f", v), "float64"
+	case float64:
+		value, valueType = fmt.Sprintf("%f", v), "float64"
+	case *String:
+		if !v.NotR {
+			value, translate = this.translateString(v), true
+		} else {
+			value = strconv.Quote(v.Value)
+		}
+		valueType = "string"
+	case *StringList:
+		value, valueType, translate = this.stringListToString(v), "[]string", !v.NotR
+	case *Enum:
+		enum := this.enumToString(prop.Pos, v.Value)
+		if enum == "" {
+			return
+		}
+		value, valueType = fmt.Sprintf("int(%s)", enum), "int"
+	case *Set:
+		set := this.setToString(prop.Pos, v)
+		if set == "" {
+			return
+		}
+		value, valueType = fmt.Sprintf("int(%s)", set), "int"
+	case *Char:
+		value, valueType = this.charToString(prop.Pos, v), "*core.QChar"
+	case *Url:
+		value, valueType = this.urlToString(prop.Pos, v), "*core.QUrl"
+	case *Date:
+		value, valueType = this.dateToString(prop.Pos, v.Year, v.Month, v.Day), "*core.QDate"
+	case *Time:
+		value, valueType = this.timeToString(prop.Pos, v.Hour, v.Minute, v.Second), "*core.QTime"
+	case *DateTime:
+		value, valueType = this.dateTimeToString(prop.Pos, v), "*core.QDateTime"
+	case *QPoint:
+		value, valueType = this.pointToString(prop.Pos, v), "*core.QPoint"
+	case *QPointF:
+		value, valueType = this.pointFToString(prop.Pos, v), "*core.QPointF"
+	case *QSize:
+		value, valueType = this.sizeToString(prop.Pos, v), "*core.QSize"
+	case *QSizeF:
+		value, valueType = this.sizeFToString(prop.Pos, v), "*core.QSizeF"
+	case *QRect:
+		value, valueType = this.rectToString(prop.Pos, v), "*core.QRect"
+	case *QRectF:
+		value, valueType = this.rectFToString(prop.Pos, v), "*core.QRectF"
+	case *QLocale:
+		value, valueType = this.localeToString(prop.Pos, v), "*core.QLocale"
+	case *QColor:
+		variant = this.colorToString(prop.Pos, v) + ".ToVariant()"
+	case *QPixmap:
+		variant = this.pixmapToString(prop.Pos, v) + ".ToVariant()"
+	case *QFont:
+		this.translateFont(prop.Pos, v)
+		variant = "font.ToVariant()"
+	case *QIcon:
+		this.translateIcon(prop.Pos, v)
+		variant = "icon.ToVariant()"
+	case *QBrush:
+		if !this.translateBrush(prop.Pos, v) {
+			return
+		}
+		variant = "brush.ToVariant()"
+	case *Cursor, *CursorShape, *QPalette, *QSizePolicy:
+		// therecipe has no QVariant constructor or ToVariant for these.
+		this.errorf(prop.Pos, "dynamic property %s of type %s cannot be stored in a QVariant", prop.Name,
+			strings.TrimPrefix(fmt.Sprintf("%T", v), "*parser."))
+		return
+	default:
+		this.errorf(prop.Pos, "dynamic property %s of this type not supported", prop.Name)
+		return
+	}
+
+	this.addImport("core")
+	if variant == "" {
+		variant = fmt.Sprintf("%s(%s)", this.constructor(prop.Pos, "QVariant", valueType), value)
+	}
+	code := fmt.Sprintf("%s.SetProperty(%s, %s)", name, strconv.Quote(prop.Name), variant)
+	if translate {
+		this.addTranslateCode(code)
+	} else {
+		this.addSetupUICode(code)
+	}
+}
+
+// stringListToString returns the []string expression of list, translated
+// unless it is notr.
+func (this *compiler) stringListToString(list *StringList) string {
+	strs := make([]string, len(list.Strings))
+	for i, s := range list.Strings {
+		if list.NotR {
+			strs[i] = strconv.Quote(s)
+			continue
+		}
+		// The meta comments are written once for the list.
+		tr := Translation{Comment: list.Comment}
+		if i == 0 {
+			tr = list.Translation
+		}
+		strs[i] = this.translateString(&String{Value: s, Translation: tr})
+	}
+	return "[]string{" + strings.Join(strs, ", ") + "}"
+}
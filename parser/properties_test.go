@@ -0,0 +1,226 @@
+package parser
+
+import (
+	"fmt"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"strings"
+)
+
+var _ = Describe("TestProperties", func() {
+	It("matches the binding", func() {
+		for class, props := range Properties {
+			source := bindingSource(ClassPackages[class])
+			for name, kind := range props {
+				// Overloaded setters are told apart by a numeric suffix.
+				setter := `func \(ptr \*` + class + `\) Set` + strings.ToUpper(name[:1]) + name[1:] + `\d*\(`
+				if kind == Settable {
+					Expect(source).To(MatchRegexp(setter), class+"."+name)
+				}
+				if kind == ReadOnly {
+					Expect(source).NotTo(MatchRegexp(setter), class+"."+name)
+				}
+			}
+		}
+	})
+
+	It("looks up the kind of properties", func() {
+		err, compiler := NewCompiler("testdata/custom.ui")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(compiler.Parse()).To(Succeed())
+
+		kind := func(class string, name string, stdset bool) string {
+			kind, ok := compiler.propertyKind(class, &Property{Name: name, StdSet: stdset})
+			return fmt.Sprint(kind, ok)
+		}
+		Expect(kind("QPushButton", "text", true)).To(Equal(fmt.Sprint(Settable, true)))
+		Expect(kind("QDialog", "modal", true)).To(Equal(fmt.Sprint(Settable, true)))
+		Expect(kind("QPushButton", "sizeHint", true)).To(Equal(fmt.Sprint(ReadOnly, true)))
+		Expect(kind("QHBoxLayout", "leftMargin", true)).To(Equal(fmt.Sprint(DesignerOnly, true)))
+		Expect(kind("QPushButton", "role", false)).To(Equal(fmt.Sprint(Dynamic, true)))
+		Expect(kind("QPushButton", "bogus", true)).To(Equal(fmt.Sprint(Settable, false)))
+		Expect(kind("ColorButton", "color", true)).To(Equal(fmt.Sprint(Settable, true)))
+		Expect(kind("ColorButton", "flat", true)).To(Equal(fmt.Sprint(Settable, true)))
+
+		Properties["ColorButton"] = map[string]PropertyKind{"round": Dynamic}
+		defer delete(Properties, "ColorButton")
+		Expect(kind("ColorButton", "round", true)).To(Equal(fmt.Sprint(Dynamic, true)))
+		Expect(kind("ColorButton", "color", true)).To(Equal(fmt.Sprint(Settable, false)))
+	})
+
+	It("sets properties by their kind", func() {
+		code, diagnostics := compile("testdata/properties.ui", nil)
+
+		for _, line := range []string{
+			`this.OkButton.SetText(_translate("Form", "OK", "", -1))`,
+			`this.OkButton.SetProperty("role", core.NewQVariant12(_translate("Form", "primary", "", -1)))`,
+			`this.OkButton.SetProperty("kind", core.NewQVariant12("accept"))`,
+			`this.OkButton.SetProperty("priority", core.NewQVariant5(2))`,
+			`this.OkButton.SetProperty("highlighted", core.NewQVariant9(true))`,
+			`this.OkButton.SetProperty("weight", core.NewQVariant10(0.500000))`,
+			`this.Swatch.SetProperty("color", gui.NewQColor3(255, 128, 0, 255).ToVariant())`,
+			`this.Swatch.SetProperty("preferredSize", core.NewQVariant25(core.NewQSize2(64, 32)))`,
+			`this.Swatch.SetProperty("area", core.NewQVariant31(core.NewQRect4(1, 2, 30, 40)))`,
+			`this.Swatch.SetProperty("tags", core.NewQVariant17([]string{_translate("Form", "warm", "", -1), _translate("Form", "bright", "", -1)}))`,
+			`this.Swatch.SetProperty("created", core.NewQVariant19(core.NewQDate3(2020, 5, 17)))`,
+			`this.Swatch.SetProperty("style", core.NewQVariant5(int(widgets.QFrame__Box)))`,
+			`this.Swatch.SetProperty("source", core.NewQVariant36(core.NewQUrl3("https://example.com/swatch", core.QUrl__TolerantMode)))`,
+			`this.Swatch.SetProperty("letter", core.NewQVariant18(core.NewQChar6(65)))`,
+			`this.Swatch.SetProperty("labelFont", font.ToVariant())`,
+		} {
+			Expect(code).To(ContainSubstring("\t" + line + "\n"))
+		}
+		Expect(code).NotTo(ContainSubstring("Bogus"))
+		Expect(code).NotTo(ContainSubstring("DisplayText"))
+		Expect(code).NotTo(ContainSubstring("CurrentTabText"))
+		Expect(diagnostics).To(Equal("testdata/properties.ui:26:6: unknown property bogus of QPushButton\n" +
+			"testdata/properties.ui:33:6: property displayText of QLineEdit is read-only\n" +
+			"testdata/properties.ui:99:6: dynamic property pointer of type CursorShape cannot be stored in a QVariant\n"))
+	})
+})
+
+var _ = Describe("TestFont", func() {
+	It("parses fonts", func() {
+		ui, err := ParseFile("testdata/font.ui")
+		Expect(err).NotTo(HaveOccurred())
+
+		font := ui.Widget.Widgets[0].Properties[0].Value.(*QFont)
+		Expect(font.Family).To(Equal(`Helvetica "Neue"`))
+		Expect(*font.PointSize).To(Equal(14))
+		Expect(*font.Weight).To(Equal(75))
+		Expect(*font.Bold).To(BeTrue())
+		Expect(*font.Italic).To(BeFalse())
+		Expect(*font.Strikeout).To(BeTrue())
+		Expect(font.StyleStrategy).To(Equal("PreferAntialias"))
+		Expect(font.Underline).To(BeNil())
+		Expect(font.AntiAliasing).To(BeNil())
+		Expect(font.Kerning).To(BeNil())
+	})
+
+	It("sets the font attributes of the ui file only", func() {
+		code := generate("testdata/font.ui")
+
+		Expect(code).To(ContainSubstring(`font.SetFamily("Helvetica \"Neue\"")
+	font.SetPointSize(14)
+	font.SetBold(true)
+	font.SetItalic(false)
+	font.SetWeight(75)
+	font.SetStrikeOut(true)
+	font.SetStyleStrategy(gui.QFont__PreferAntialias)
+	this.Title.SetFont(font)`))
+
+		Expect(code).To(ContainSubstring(`font = gui.NewQFont()
+	font.SetUnderline(true)
+	font.SetKerning(false)
+	font.SetStyleStrategy(gui.QFont__NoAntialias)
+	this.Note.SetFont(font)`))
+	})
+})
+
+var _ = Describe("TestValues", func() {
+	It("parses string lists, chars and urls", func() {
+		ui, err := ParseFile("testdata/values.ui")
+		Expect(err).NotTo(HaveOccurred())
+
+		browser := ui.Widget.Layout.Items[0].View.(*QWidget)
+		Expect(browser.Properties[0].Value).To(Equal(&Url{String: "qrc:/help/index.html"}))
+		Expect(browser.Properties[1].Value).To(Equal(&StringList{NotR: true, Strings: []string{"qrc:/help", "docs"}}))
+		field := ui.Widget.Layout.Items[1].View.(*QWidget)
+		Expect(field.Properties[0].Value).To(Equal(&Char{Unicode: 9679}))
+	})
+
+	It("sets urls", func() {
+		code := generate("testdata/values.ui")
+		Expect(code).To(ContainSubstring(`this.Browser.SetSource(core.NewQUrl3("qrc:/help/index.html", core.QUrl__TolerantMode))`))
+	})
+
+	It("sets string lists, translated unless notr", func() {
+		code := generate("testdata/values.ui")
+		Expect(code).To(ContainSubstring(`this.Browser.SetSearchPaths([]string{"qrc:/help", "docs"})`))
+		Expect(code).To(ContainSubstring(`_translate := core.QCoreApplication_Translate
+	this.Field.SetProperty("suggestions", core.NewQVariant17([]string{_translate("Form", "Yes", "", -1), _translate("Form", "No", "", -1)}))`))
+	})
+
+	It("sets chars", func() {
+		code := generate("testdata/values.ui")
+		Expect(code).To(ContainSubstring(`this.Field.SetProperty("maskChar", core.NewQVariant18(core.NewQChar6(9679)))`))
+	})
+
+	It("type-checks", func() {
+		typeCheck("testdata/values.ui")
+	})
+})
+
+var _ = Describe("TestTranslations", func() {
+	It("parses translator attributes", func() {
+		ui, err := ParseFile("testdata/translations.ui")
+		Expect(err).NotTo(HaveOccurred())
+
+		label := ui.Widget.Layout.Items[0].View.(*QWidget)
+		Expect(label.Properties[0].Value.(*String).Translation).To(Equal(Translation{
+			Comment:      "verb",
+			ExtraComment: "Shown at startup.\nKeep it short.",
+			Id:           "hello.label",
+		}))
+	})
+
+	It("passes the comment as disambiguation and writes meta comments", func() {
+		code := generate("testdata/translations.ui")
+
+		Expect(code).To(ContainSubstring(`Form.SetWindowTitle(_translate("Form", "Open", "window", -1))`))
+		Expect(code).To(ContainSubstring(`	//: Shown at startup.
+	//: Keep it short.
+	//= hello.label
+	this.Label.SetText(_translate("Form", "Open", "verb", -1))`))
+		Expect(code).To(ContainSubstring(`this.Combo.SetItemText(0, _translate("Form", "Open", "state", -1))`))
+		Expect(code).To(ContainSubstring(`	//: Door states
+	this.Combo.SetProperty("choices", core.NewQVariant17([]string{_translate("Form", "Open", "state", -1), _translate("Form", "Closed", "state", -1)}))`))
+	})
+})
+
+var _ = Describe("TestBrush", func() {
+	It("parses gradients and textures", func() {
+		ui, err := ParseFile("testdata/brush.ui")
+		Expect(err).NotTo(HaveOccurred())
+
+		palette := ui.Widget.Properties[0].Value.(*QPalette)
+		gradient := palette.Active.Items[0].ColorRole.Brush.Gradient
+		Expect(gradient.Type).To(Equal("LinearGradient"))
+		Expect(gradient.GradientStops).To(HaveLen(2))
+		Expect(gradient.GradientStops[1].Colors[0].Blue).To(Equal(255))
+		Expect(palette.Active.Items[0].ColorRole.Brush.Color).To(BeNil())
+
+		texture := palette.Disabled.Items[1].ColorRole.Brush.Texture
+		Expect(texture.Value).To(Equal(&QPixmap{Value: "images/texture.png"}))
+	})
+
+	It("generates gradient and texture brushes", func() {
+		code := generate("testdata/brush.ui")
+
+		Expect(code).To(ContainSubstring(`linearGradient = gui.NewQLinearGradient3(0.000000, 0.000000, 1.000000, 0.000000)
+	linearGradient.SetSpread(gui.QGradient__PadSpread)
+	linearGradient.SetCoordinateMode(gui.QGradient__ObjectBoundingMode)
+	linearGradient.SetColorAt(0.000000, gui.NewQColor3(255, 0, 0, 255))
+	linearGradient.SetColorAt(1.000000, gui.NewQColor3(0, 0, 255, 255))
+	brush = gui.NewQBrush10(linearGradient)
+	palette.SetBrush2(gui.QPalette__Active, gui.QPalette__Button, brush)`))
+
+		Expect(code).To(ContainSubstring(`radialGradient = gui.NewQRadialGradient3(0.500000, 0.500000, 0.500000, 0.500000, 0.500000)
+	radialGradient.SetSpread(gui.QGradient__ReflectSpread)
+	radialGradient.SetCoordinateMode(gui.QGradient__StretchToDeviceMode)
+	radialGradient.SetColorAt(0.500000, gui.NewQColor3(0, 255, 0, 128))
+	brush = gui.NewQBrush10(radialGradient)
+	palette.SetBrush2(gui.QPalette__Inactive, gui.QPalette__Button, brush)`))
+
+		Expect(code).To(ContainSubstring(`conicalGradient = gui.NewQConicalGradient3(0.500000, 0.500000, 90.000000)
+	conicalGradient.SetSpread(gui.QGradient__RepeatSpread)
+	conicalGradient.SetCoordinateMode(gui.QGradient__LogicalMode)`))
+
+		Expect(code).To(ContainSubstring(`brush = gui.NewQBrush7(gui.NewQPixmap3("images/texture.png", "", core.Qt__AutoColor))
+	palette.SetBrush2(gui.QPalette__Disabled, gui.QPalette__Window, brush)`))
+
+		Expect(code).To(ContainSubstring(`linearGradient = gui.NewQLinearGradient3(0.000000, 0.000000, 0.000000, 1.000000)`))
+		Expect(code).To(ContainSubstring(`this.View.SetBackgroundBrush(brush)`))
+		Expect(strings.Count(code, "var linearGradient *gui.QLinearGradient")).To(Equal(1))
+	})
+})
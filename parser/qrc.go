@@ -0,0 +1,92 @@
+package parser
+
+import (
+	xmlx "github.com/stephenlyu/go-pkg-xmlx"
+	"path"
+	"sort"
+)
+
+// Qrc is a parsed Qt resource collection (.qrc) file.
+type Qrc struct {
+	File      string
+	Resources []*QrcResource
+}
+
+type QrcResource struct {
+	Prefix string
+	Lang   string
+	Files  []*QrcFile
+}
+
+type QrcFile struct {
+	Path  string // file path, relative to the .qrc file
+	Alias string
+}
+
+// Name returns the name of the file in the resource tree, i.e. the alias
+// if set and the file path otherwise.
+func (this *QrcFile) Name() string {
+	if this.Alias != "" {
+		return this.Alias
+	}
+	return this.Path
+}
+
+// ResourcePath returns the ":/prefix/name" path used to load file.
+func (this *QrcResource) ResourcePath(file *QrcFile) string {
+	return ":" + path.Join("/", this.Prefix, file.Name())
+}
+
+// ResourcePaths returns the sorted ":/prefix/name" paths of all files.
+func (this *Qrc) ResourcePaths() []string {
+	paths := []string{}
+	for _, res := range this.Resources {
+		for _, file := range res.Files {
+			paths = append(paths, res.ResourcePath(file))
+		}
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+// ParseQrcFile parses the .qrc file qrcFile.
+func ParseQrcFile(qrcFile string) (*Qrc, error) {
+	err, p := NewParser(qrcFile)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := p.parseQrc()
+	if len(p.errors) > 0 {
+		return nil, p.errors
+	}
+	return ret, nil
+}
+
+func (this *parser) parseQrc() *Qrc {
+	defer this.catchBailout()
+
+	ret := &Qrc{File: this.File}
+	rootNode := this.doc.SelectNode("", "RCC")
+	if rootNode == nil {
+		this.errorf(this.doc.Root, "no RCC element")
+		return nil
+	}
+
+	for _, n := range rootNode.SelectNodesDirect("", "qresource") {
+		res := &QrcResource{Prefix: n.As("", "prefix"), Lang: n.As("", "lang")}
+		for _, ch := range n.SelectNodesDirect("", "file") {
+			res.Files = append(res.Files, this.parseQrcFile(ch))
+		}
+		ret.Resources = append(ret.Resources, res)
+	}
+	return ret
+}
+
+func (this *parser) parseQrcFile(n *xmlx.Node) *QrcFile {
+	file := &QrcFile{Path: n.GetValue(), Alias: n.As("", "alias")}
+	if file.Path == "" {
+		this.errorf(n, "empty file name")
+	}
+	return file
+}
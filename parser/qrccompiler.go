@@ -0,0 +1,117 @@
+package parser
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+type qrcCompiler struct {
+	*Qrc
+}
+
+// NewQrcCompiler returns a compiler generating Go code that embeds the files
+// of qrcFile and registers them with the Qt resource system.
+func NewQrcCompiler(qrcFile string) (error, *qrcCompiler) {
+	qrc, err := ParseQrcFile(qrcFile)
+	if err != nil {
+		return err, nil
+	}
+	return nil, &qrcCompiler{Qrc: qrc}
+}
+
+// varPrefix returns the prefix of the variables holding the embedded files,
+// e.g. "mainQrc" for main.qrc.
+func (this *qrcCompiler) varPrefix() string {
+	name := strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return r
+		}
+		return '_'
+	}, filepath.Base(this.File))
+	name = strings.Replace(ToCamelCase(strings.ToLower(name)), "_", "", -1)
+	if name == "" || !unicode.IsLetter([]rune(name)[0]) {
+		name = "Qrc" + name
+	}
+	return strings.ToLower(name[:1]) + name[1:]
+}
+
+// embedPattern returns the //go:embed pattern of file. Embedded files must be
+// in the directory of the Go file or below it.
+func embedPattern(goDir string, file string) (string, error) {
+	rel, err := filepath.Rel(goDir, file)
+	if err != nil {
+		return "", err
+	}
+	rel = filepath.ToSlash(rel)
+	if rel == ".." || strings.HasPrefix(rel, "../") {
+		return "", fmt.Errorf("%s is outside of %s and cannot be embedded", file, goDir)
+	}
+	if strings.ContainsAny(rel, " \"`") {
+		return strconv.Quote(rel), nil
+	}
+	return rel, nil
+}
+
+// GenerateCode writes the Go file goFile of package packageName.
+func (this *qrcCompiler) GenerateCode(packageName string, goFile string) error {
+	qrcDir, _ := filepath.Abs(filepath.Dir(this.File))
+	goDir, _ := filepath.Abs(filepath.Dir(goFile))
+	prefix := this.varPrefix()
+
+	embedCodes := []string{}
+	fileCodes := []string{}
+	vars := map[string]string{} // embed pattern -> variable
+
+	for _, res := range this.Resources {
+		for _, file := range res.Files {
+			filePath := filepath.Join(qrcDir, filepath.FromSlash(file.Path))
+			if _, err := os.Stat(filePath); err != nil {
+				return fmt.Errorf("%s: cannot find file %s", this.File, file.Path)
+			}
+
+			pattern, err := embedPattern(goDir, filePath)
+			if err != nil {
+				return err
+			}
+
+			varName, ok := vars[pattern]
+			if !ok {
+				varName = fmt.Sprintf("%s%d", prefix, len(vars))
+				vars[pattern] = varName
+				embedCodes = append(embedCodes, fmt.Sprintf("//go:embed %s\nvar %s []byte\n", pattern, varName))
+			}
+
+			resourcePath := path.Join("/", res.Prefix, file.Name())
+			if res.Lang != "" {
+				fileCodes = append(fileCodes, fmt.Sprintf("        {Path: %q, Lang: %q, Data: %s},", resourcePath, res.Lang, varName))
+			} else {
+				fileCodes = append(fileCodes, fmt.Sprintf("        {Path: %q, Data: %s},", resourcePath, varName))
+			}
+		}
+	}
+
+	code := fmt.Sprintf(`// WARNING! All changes made in this file will be lost!
+package %s
+
+import (
+    _ "embed"
+    "github.com/stephenlyu/goqtuic/rcc"
+)
+
+%s
+func init() {
+    rcc.Init([]*rcc.File{
+%s
+    })
+}
+`, packageName,
+		strings.Join(embedCodes, "\n"),
+		strings.Join(fileCodes, "\n"))
+
+	return writeSource(goFile, code)
+}
@@ -0,0 +1,61 @@
+package parser
+
+import (
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// loadResources reads the .qrc files referenced by the ui file, so that
+// resource paths can be checked, and imports the Go packages registered for
+// them in ResourceImports.
+func (this *compiler) loadResources() {
+	this.resourcePaths = make(map[string]bool)
+
+	for _, res := range this.Resources {
+		qrcFile := filepath.Join(filepath.Dir(this.File), filepath.FromSlash(res.Location))
+
+		qrc, err := ParseQrcFile(qrcFile)
+		if err != nil {
+			this.warnf(res.Pos, "cannot read resource file %s: %v", res.Location, err)
+		} else {
+			for _, path := range qrc.ResourcePaths() {
+				this.resourcePaths[path] = true
+			}
+		}
+
+		if _import := this.resourceImport(qrcFile); _import != "" {
+			this.addNamedImport(_import, "_")
+		}
+	}
+}
+
+// resourceImport returns the Go package registered for qrcFile in
+// ResourceImports, "" if there is none.
+func (this *compiler) resourceImport(qrcFile string) string {
+	files := make([]string, 0, len(this.ResourceImports))
+	for file := range this.ResourceImports {
+		files = append(files, file)
+	}
+	sort.Strings(files)
+
+	absFile, _ := filepath.Abs(qrcFile)
+	for _, file := range files {
+		if abs, _ := filepath.Abs(file); abs == absFile {
+			return this.ResourceImports[file]
+		}
+	}
+	return ""
+}
+
+// checkResourcePath warns if the resource path file, e.g.
+// ":/images/ok.png", is not found in any .qrc file referenced by the ui
+// file. Plain file paths are not checked.
+func (this *compiler) checkResourcePath(pos Position, file string) {
+	if !strings.HasPrefix(file, ":") {
+		return
+	}
+	if !this.resourcePaths[file] {
+		this.warnf(pos, "resource %s not found in any referenced .qrc file", file)
+	}
+}
@@ -0,0 +1,57 @@
+package parser
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+var _ = Describe("TestResources", func() {
+	It("parses qrc files", func() {
+		qrc, err := ParseQrcFile("testdata/custom.qrc")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(qrc.Resources).To(HaveLen(2))
+		Expect(qrc.ResourcePaths()).To(Equal([]string{":/checked/ui/images/checked.png", ":/icons/ok.png"}))
+	})
+
+	It("checks and imports resources", func() {
+		code, diagnostics := compile("testdata/custom.ui", func(compiler *compiler) {
+			compiler.ResourceImports["testdata/custom.qrc"] = "github.com/example/resources"
+		})
+		Expect(code).To(ContainSubstring(`_ "github.com/example/resources"`))
+
+		Expect(diagnostics).To(Equal("testdata/custom.ui:30:11: warning: resource :/missing.png not found in any referenced .qrc file\n"))
+	})
+})
+
+var _ = Describe("TestQrcCompiler", func() {
+	It("test", func() {
+		err, compiler := NewQrcCompiler("testdata/custom.qrc")
+		Expect(err).NotTo(HaveOccurred())
+
+		goFile := "testdata/custom_qrc.go"
+		defer os.Remove(goFile)
+		Expect(compiler.GenerateCode("main", goFile)).To(Succeed())
+		data, _ := ioutil.ReadFile(goFile)
+		code := string(data)
+
+		Expect(code).To(ContainSubstring("func init() {\n\trcc.Init([]*rcc.File{\n"))
+		Expect(code).To(ContainSubstring("//go:embed ui/images/checked.png\nvar customQrc0 []byte"))
+		Expect(code).To(ContainSubstring("//go:embed ui/images/unchecked.png\nvar customQrc1 []byte"))
+		Expect(code).To(ContainSubstring(`{Path: "/checked/ui/images/checked.png", Data: customQrc0},`))
+		Expect(code).To(ContainSubstring(`{Path: "/icons/ok.png", Data: customQrc1},`))
+	})
+
+	It("rejects files outside of the go file directory", func() {
+		err, compiler := NewQrcCompiler("testdata/custom.qrc")
+		Expect(err).NotTo(HaveOccurred())
+
+		dir, err := ioutil.TempDir("", "goqtuic")
+		Expect(err).NotTo(HaveOccurred())
+		defer os.RemoveAll(dir)
+
+		Expect(compiler.GenerateCode("main", filepath.Join(dir, "qrc.go"))).To(MatchError(ContainSubstring("cannot be embedded")))
+	})
+})
@@ -0,0 +1,952 @@
+package parser
+
+import (
+	"fmt"
+	"strings"
+)
+
+// SignalOverload is a Go connector of a Qt signal.
+type SignalOverload struct {
+	Connector string   // e.g. ConnectActivated2
+	Params    []string // Go parameter types, e.g. "string"
+}
+
+// Signals lists, per class, the connectors of the signals of the objects a
+// form can connect in the therecipe binding: widgets, layouts, actions and
+// their groups. Overloaded signals have a connector per overload, told apart
+// by a numeric suffix, e.g. QComboBox::activated(int) is ConnectActivated and
+// activated(QString) is ConnectActivated2. Entries can be added for custom
+// classes, whose other signals are connected with Connect<Signal> unchecked.
+// The table is maintained by hand: to add a signal, copy its connectors, in
+// the binding's order, from the source of the binding's package. TestSignals
+// checks the connectors and their parameter types against the binding.
+var Signals = map[string]map[string][]*SignalOverload{
+	"QAbstractButton": {
+		"clicked": {
+			{"ConnectClicked", []string{"bool"}},
+		},
+		"pressed": {
+			{"ConnectPressed", []string{}},
+		},
+		"released": {
+			{"ConnectReleased", []string{}},
+		},
+		"toggled": {
+			{"ConnectToggled", []string{"bool"}},
+		},
+	},
+	"QAbstractItemView": {
+		"activated": {
+			{"ConnectActivated", []string{"*core.QModelIndex"}},
+		},
+		"clicked": {
+			{"ConnectClicked", []string{"*core.QModelIndex"}},
+		},
+		"doubleClicked": {
+			{"ConnectDoubleClicked", []string{"*core.QModelIndex"}},
+		},
+		"entered": {
+			{"ConnectEntered", []string{"*core.QModelIndex"}},
+		},
+		"iconSizeChanged": {
+			{"ConnectIconSizeChanged", []string{"*core.QSize"}},
+		},
+		"pressed": {
+			{"ConnectPressed", []string{"*core.QModelIndex"}},
+		},
+		"viewportEntered": {
+			{"ConnectViewportEntered", []string{}},
+		},
+	},
+	"QAbstractSlider": {
+		"actionTriggered": {
+			{"ConnectActionTriggered", []string{"int"}},
+		},
+		"rangeChanged": {
+			{"ConnectRangeChanged", []string{"int", "int"}},
+		},
+		"sliderMoved": {
+			{"ConnectSliderMoved", []string{"int"}},
+		},
+		"sliderPressed": {
+			{"ConnectSliderPressed", []string{}},
+		},
+		"sliderReleased": {
+			{"ConnectSliderReleased", []string{}},
+		},
+		"valueChanged": {
+			{"ConnectValueChanged", []string{"int"}},
+		},
+	},
+	"QAbstractSpinBox": {
+		"editingFinished": {
+			{"ConnectEditingFinished", []string{}},
+		},
+	},
+	"QAction": {
+		"changed": {
+			{"ConnectChanged", []string{}},
+		},
+		"hovered": {
+			{"ConnectHovered", []string{}},
+		},
+		"toggled": {
+			{"ConnectToggled", []string{"bool"}},
+		},
+		"triggered": {
+			{"ConnectTriggered", []string{"bool"}},
+		},
+	},
+	"QActionGroup": {
+		"hovered": {
+			{"ConnectHovered", []string{"*widgets.QAction"}},
+		},
+		"triggered": {
+			{"ConnectTriggered", []string{"*widgets.QAction"}},
+		},
+	},
+	"QButtonGroup": {
+		"buttonClicked": {
+			{"ConnectButtonClicked", []string{"*widgets.QAbstractButton"}},
+			{"ConnectButtonClicked2", []string{"int"}},
+		},
+		"buttonPressed": {
+			{"ConnectButtonPressed", []string{"*widgets.QAbstractButton"}},
+			{"ConnectButtonPressed2", []string{"int"}},
+		},
+		"buttonReleased": {
+			{"ConnectButtonReleased", []string{"*widgets.QAbstractButton"}},
+			{"ConnectButtonReleased2", []string{"int"}},
+		},
+		"buttonToggled": {
+			{"ConnectButtonToggled", []string{"*widgets.QAbstractButton", "bool"}},
+			{"ConnectButtonToggled2", []string{"int", "bool"}},
+		},
+	},
+	"QCalendarWidget": {
+		"activated": {
+			{"ConnectActivated", []string{"*core.QDate"}},
+		},
+		"clicked": {
+			{"ConnectClicked", []string{"*core.QDate"}},
+		},
+		"currentPageChanged": {
+			{"ConnectCurrentPageChanged", []string{"int", "int"}},
+		},
+		"selectionChanged": {
+			{"ConnectSelectionChanged", []string{}},
+		},
+	},
+	"QCheckBox": {
+		"stateChanged": {
+			{"ConnectStateChanged", []string{"int"}},
+		},
+	},
+	"QColorDialog": {
+		"colorSelected": {
+			{"ConnectColorSelected", []string{"*gui.QColor"}},
+		},
+		"currentColorChanged": {
+			{"ConnectCurrentColorChanged", []string{"*gui.QColor"}},
+		},
+	},
+	"QColumnView": {
+		"updatePreviewWidget": {
+			{"ConnectUpdatePreviewWidget", []string{"*core.QModelIndex"}},
+		},
+	},
+	"QComboBox": {
+		"activated": {
+			{"ConnectActivated", []string{"int"}},
+			{"ConnectActivated2", []string{"string"}},
+		},
+		"currentIndexChanged": {
+			{"ConnectCurrentIndexChanged", []string{"int"}},
+		},
+		"currentTextChanged": {
+			{"ConnectCurrentTextChanged", []string{"string"}},
+		},
+		"editTextChanged": {
+			{"ConnectEditTextChanged", []string{"string"}},
+		},
+		"highlighted": {
+			{"ConnectHighlighted", []string{"int"}},
+			{"ConnectHighlighted2", []string{"string"}},
+		},
+	},
+	"QDateTimeEdit": {
+		"dateChanged": {
+			{"ConnectDateChanged", []string{"*core.QDate"}},
+		},
+		"dateTimeChanged": {
+			{"ConnectDateTimeChanged", []string{"*core.QDateTime"}},
+		},
+		"timeChanged": {
+			{"ConnectTimeChanged", []string{"*core.QTime"}},
+		},
+	},
+	"QDesktopWidget": {
+		"primaryScreenChanged": {
+			{"ConnectPrimaryScreenChanged", []string{}},
+		},
+		"resized": {
+			{"ConnectResized", []string{"int"}},
+		},
+		"screenCountChanged": {
+			{"ConnectScreenCountChanged", []string{"int"}},
+		},
+		"workAreaResized": {
+			{"ConnectWorkAreaResized", []string{"int"}},
+		},
+	},
+	"QDialog": {
+		"accepted": {
+			{"ConnectAccepted", []string{}},
+		},
+		"finished": {
+			{"ConnectFinished", []string{"int"}},
+		},
+		"rejected": {
+			{"ConnectRejected", []string{}},
+		},
+	},
+	"QDialogButtonBox": {
+		"accepted": {
+			{"ConnectAccepted", []string{}},
+		},
+		"clicked": {
+			{"ConnectClicked", []string{"*widgets.QAbstractButton"}},
+		},
+		"helpRequested": {
+			{"ConnectHelpRequested", []string{}},
+		},
+		"rejected": {
+			{"ConnectRejected", []string{}},
+		},
+	},
+	"QDockWidget": {
+		"allowedAreasChanged": {
+			{"ConnectAllowedAreasChanged", []string{"core.Qt__DockWidgetArea"}},
+		},
+		"dockLocationChanged": {
+			{"ConnectDockLocationChanged", []string{"core.Qt__DockWidgetArea"}},
+		},
+		"featuresChanged": {
+			{"ConnectFeaturesChanged", []string{"widgets.QDockWidget__DockWidgetFeature"}},
+		},
+		"topLevelChanged": {
+			{"ConnectTopLevelChanged", []string{"bool"}},
+		},
+		"visibilityChanged": {
+			{"ConnectVisibilityChanged", []string{"bool"}},
+		},
+	},
+	"QDoubleSpinBox": {
+		"valueChanged": {
+			{"ConnectValueChanged", []string{"float64"}},
+			{"ConnectValueChanged2", []string{"string"}},
+		},
+	},
+	"QFileDialog": {
+		"currentChanged": {
+			{"ConnectCurrentChanged", []string{"string"}},
+		},
+		"currentUrlChanged": {
+			{"ConnectCurrentUrlChanged", []string{"*core.QUrl"}},
+		},
+		"directoryEntered": {
+			{"ConnectDirectoryEntered", []string{"string"}},
+		},
+		"directoryUrlEntered": {
+			{"ConnectDirectoryUrlEntered", []string{"*core.QUrl"}},
+		},
+		"fileSelected": {
+			{"ConnectFileSelected", []string{"string"}},
+		},
+		"filesSelected": {
+			{"ConnectFilesSelected", []string{"[]string"}},
+		},
+		"filterSelected": {
+			{"ConnectFilterSelected", []string{"string"}},
+		},
+		"urlSelected": {
+			{"ConnectUrlSelected", []string{"*core.QUrl"}},
+		},
+		"urlsSelected": {
+			{"ConnectUrlsSelected", []string{"[]*core.QUrl"}},
+		},
+	},
+	"QFontComboBox": {
+		"currentFontChanged": {
+			{"ConnectCurrentFontChanged", []string{"*gui.QFont"}},
+		},
+	},
+	"QFontDialog": {
+		"currentFontChanged": {
+			{"ConnectCurrentFontChanged", []string{"*gui.QFont"}},
+		},
+		"fontSelected": {
+			{"ConnectFontSelected", []string{"*gui.QFont"}},
+		},
+	},
+	"QGraphicsView": {
+		"rubberBandChanged": {
+			{"ConnectRubberBandChanged", []string{"*core.QRect", "*core.QPointF", "*core.QPointF"}},
+		},
+	},
+	"QGroupBox": {
+		"clicked": {
+			{"ConnectClicked", []string{"bool"}},
+		},
+		"toggled": {
+			{"ConnectToggled", []string{"bool"}},
+		},
+	},
+	"QHeaderView": {
+		"geometriesChanged": {
+			{"ConnectGeometriesChanged", []string{}},
+		},
+		"sectionClicked": {
+			{"ConnectSectionClicked", []string{"int"}},
+		},
+		"sectionCountChanged": {
+			{"ConnectSectionCountChanged", []string{"int", "int"}},
+		},
+		"sectionDoubleClicked": {
+			{"ConnectSectionDoubleClicked", []string{"int"}},
+		},
+		"sectionEntered": {
+			{"ConnectSectionEntered", []string{"int"}},
+		},
+		"sectionHandleDoubleClicked": {
+			{"ConnectSectionHandleDoubleClicked", []string{"int"}},
+		},
+		"sectionMoved": {
+			{"ConnectSectionMoved", []string{"int", "int", "int"}},
+		},
+		"sectionPressed": {
+			{"ConnectSectionPressed", []string{"int"}},
+		},
+		"sectionResized": {
+			{"ConnectSectionResized", []string{"int", "int", "int"}},
+		},
+		"sortIndicatorChanged": {
+			{"ConnectSortIndicatorChanged", []string{"int", "core.Qt__SortOrder"}},
+		},
+	},
+	"QInputDialog": {
+		"doubleValueChanged": {
+			{"ConnectDoubleValueChanged", []string{"float64"}},
+		},
+		"doubleValueSelected": {
+			{"ConnectDoubleValueSelected", []string{"float64"}},
+		},
+		"intValueChanged": {
+			{"ConnectIntValueChanged", []string{"int"}},
+		},
+		"intValueSelected": {
+			{"ConnectIntValueSelected", []string{"int"}},
+		},
+		"textValueChanged": {
+			{"ConnectTextValueChanged", []string{"string"}},
+		},
+		"textValueSelected": {
+			{"ConnectTextValueSelected", []string{"string"}},
+		},
+	},
+	"QKeySequenceEdit": {
+		"editingFinished": {
+			{"ConnectEditingFinished", []string{}},
+		},
+		"keySequenceChanged": {
+			{"ConnectKeySequenceChanged", []string{"*gui.QKeySequence"}},
+		},
+	},
+	"QLCDNumber": {
+		"overflow": {
+			{"ConnectOverflow", []string{}},
+		},
+	},
+	"QLabel": {
+		"linkActivated": {
+			{"ConnectLinkActivated", []string{"string"}},
+		},
+		"linkHovered": {
+			{"ConnectLinkHovered", []string{"string"}},
+		},
+	},
+	"QLineEdit": {
+		"cursorPositionChanged": {
+			{"ConnectCursorPositionChanged", []string{"int", "int"}},
+		},
+		"editingFinished": {
+			{"ConnectEditingFinished", []string{}},
+		},
+		"inputRejected": {
+			{"ConnectInputRejected", []string{}},
+		},
+		"returnPressed": {
+			{"ConnectReturnPressed", []string{}},
+		},
+		"selectionChanged": {
+			{"ConnectSelectionChanged", []string{}},
+		},
+		"textChanged": {
+			{"ConnectTextChanged", []string{"string"}},
+		},
+		"textEdited": {
+			{"ConnectTextEdited", []string{"string"}},
+		},
+	},
+	"QListWidget": {
+		"currentItemChanged": {
+			{"ConnectCurrentItemChanged", []string{"*widgets.QListWidgetItem", "*widgets.QListWidgetItem"}},
+		},
+		"currentRowChanged": {
+			{"ConnectCurrentRowChanged", []string{"int"}},
+		},
+		"currentTextChanged": {
+			{"ConnectCurrentTextChanged", []string{"string"}},
+		},
+		"itemActivated": {
+			{"ConnectItemActivated", []string{"*widgets.QListWidgetItem"}},
+		},
+		"itemChanged": {
+			{"ConnectItemChanged", []string{"*widgets.QListWidgetItem"}},
+		},
+		"itemClicked": {
+			{"ConnectItemClicked", []string{"*widgets.QListWidgetItem"}},
+		},
+		"itemDoubleClicked": {
+			{"ConnectItemDoubleClicked", []string{"*widgets.QListWidgetItem"}},
+		},
+		"itemEntered": {
+			{"ConnectItemEntered", []string{"*widgets.QListWidgetItem"}},
+		},
+		"itemPressed": {
+			{"ConnectItemPressed", []string{"*widgets.QListWidgetItem"}},
+		},
+		"itemSelectionChanged": {
+			{"ConnectItemSelectionChanged", []string{}},
+		},
+	},
+	"QMainWindow": {
+		"iconSizeChanged": {
+			{"ConnectIconSizeChanged", []string{"*core.QSize"}},
+		},
+		"tabifiedDockWidgetActivated": {
+			{"ConnectTabifiedDockWidgetActivated", []string{"*widgets.QDockWidget"}},
+		},
+		"toolButtonStyleChanged": {
+			{"ConnectToolButtonStyleChanged", []string{"core.Qt__ToolButtonStyle"}},
+		},
+	},
+	"QMdiArea": {
+		"subWindowActivated": {
+			{"ConnectSubWindowActivated", []string{"*widgets.QMdiSubWindow"}},
+		},
+	},
+	"QMdiSubWindow": {
+		"aboutToActivate": {
+			{"ConnectAboutToActivate", []string{}},
+		},
+		"windowStateChanged": {
+			{"ConnectWindowStateChanged", []string{"core.Qt__WindowState", "core.Qt__WindowState"}},
+		},
+	},
+	"QMenu": {
+		"aboutToHide": {
+			{"ConnectAboutToHide", []string{}},
+		},
+		"aboutToShow": {
+			{"ConnectAboutToShow", []string{}},
+		},
+		"hovered": {
+			{"ConnectHovered", []string{"*widgets.QAction"}},
+		},
+		"triggered": {
+			{"ConnectTriggered", []string{"*widgets.QAction"}},
+		},
+	},
+	"QMenuBar": {
+		"hovered": {
+			{"ConnectHovered", []string{"*widgets.QAction"}},
+		},
+		"triggered": {
+			{"ConnectTriggered", []string{"*widgets.QAction"}},
+		},
+	},
+	"QMessageBox": {
+		"buttonClicked": {
+			{"ConnectButtonClicked", []string{"*widgets.QAbstractButton"}},
+		},
+	},
+	"QObject": {
+		"destroyed": {
+			{"ConnectDestroyed", []string{"*core.QObject"}},
+		},
+		"objectNameChanged": {
+			{"ConnectObjectNameChanged", []string{"string"}},
+		},
+	},
+	"QOpenGLWidget": {
+		"aboutToCompose": {
+			{"ConnectAboutToCompose", []string{}},
+		},
+		"aboutToResize": {
+			{"ConnectAboutToResize", []string{}},
+		},
+		"frameSwapped": {
+			{"ConnectFrameSwapped", []string{}},
+		},
+		"resized": {
+			{"ConnectResized", []string{}},
+		},
+	},
+	"QPlainTextEdit": {
+		"blockCountChanged": {
+			{"ConnectBlockCountChanged", []string{"int"}},
+		},
+		"copyAvailable": {
+			{"ConnectCopyAvailable", []string{"bool"}},
+		},
+		"cursorPositionChanged": {
+			{"ConnectCursorPositionChanged", []string{}},
+		},
+		"modificationChanged": {
+			{"ConnectModificationChanged", []string{"bool"}},
+		},
+		"redoAvailable": {
+			{"ConnectRedoAvailable", []string{"bool"}},
+		},
+		"selectionChanged": {
+			{"ConnectSelectionChanged", []string{}},
+		},
+		"textChanged": {
+			{"ConnectTextChanged", []string{}},
+		},
+		"undoAvailable": {
+			{"ConnectUndoAvailable", []string{"bool"}},
+		},
+		"updateRequest": {
+			{"ConnectUpdateRequest", []string{"*core.QRect", "int"}},
+		},
+	},
+	"QProgressBar": {
+		"valueChanged": {
+			{"ConnectValueChanged", []string{"int"}},
+		},
+	},
+	"QProgressDialog": {
+		"canceled": {
+			{"ConnectCanceled", []string{}},
+		},
+	},
+	"QSpinBox": {
+		"valueChanged": {
+			{"ConnectValueChanged", []string{"int"}},
+			{"ConnectValueChanged2", []string{"string"}},
+		},
+	},
+	"QSplashScreen": {
+		"messageChanged": {
+			{"ConnectMessageChanged", []string{"string"}},
+		},
+	},
+	"QSplitter": {
+		"splitterMoved": {
+			{"ConnectSplitterMoved", []string{"int", "int"}},
+		},
+	},
+	"QStackedLayout": {
+		"currentChanged": {
+			{"ConnectCurrentChanged", []string{"int"}},
+		},
+		"widgetRemoved": {
+			{"ConnectWidgetRemoved", []string{"int"}},
+		},
+	},
+	"QStackedWidget": {
+		"currentChanged": {
+			{"ConnectCurrentChanged", []string{"int"}},
+		},
+		"widgetRemoved": {
+			{"ConnectWidgetRemoved", []string{"int"}},
+		},
+	},
+	"QStatusBar": {
+		"messageChanged": {
+			{"ConnectMessageChanged", []string{"string"}},
+		},
+	},
+	"QTabBar": {
+		"currentChanged": {
+			{"ConnectCurrentChanged", []string{"int"}},
+		},
+		"tabBarClicked": {
+			{"ConnectTabBarClicked", []string{"int"}},
+		},
+		"tabBarDoubleClicked": {
+			{"ConnectTabBarDoubleClicked", []string{"int"}},
+		},
+		"tabCloseRequested": {
+			{"ConnectTabCloseRequested", []string{"int"}},
+		},
+		"tabMoved": {
+			{"ConnectTabMoved", []string{"int", "int"}},
+		},
+	},
+	"QTabWidget": {
+		"currentChanged": {
+			{"ConnectCurrentChanged", []string{"int"}},
+		},
+		"tabBarClicked": {
+			{"ConnectTabBarClicked", []string{"int"}},
+		},
+		"tabBarDoubleClicked": {
+			{"ConnectTabBarDoubleClicked", []string{"int"}},
+		},
+		"tabCloseRequested": {
+			{"ConnectTabCloseRequested", []string{"int"}},
+		},
+	},
+	"QTableWidget": {
+		"cellActivated": {
+			{"ConnectCellActivated", []string{"int", "int"}},
+		},
+		"cellChanged": {
+			{"ConnectCellChanged", []string{"int", "int"}},
+		},
+		"cellClicked": {
+			{"ConnectCellClicked", []string{"int", "int"}},
+		},
+		"cellDoubleClicked": {
+			{"ConnectCellDoubleClicked", []string{"int", "int"}},
+		},
+		"cellEntered": {
+			{"ConnectCellEntered", []string{"int", "int"}},
+		},
+		"cellPressed": {
+			{"ConnectCellPressed", []string{"int", "int"}},
+		},
+		"currentCellChanged": {
+			{"ConnectCurrentCellChanged", []string{"int", "int", "int", "int"}},
+		},
+		"currentItemChanged": {
+			{"ConnectCurrentItemChanged", []string{"*widgets.QTableWidgetItem", "*widgets.QTableWidgetItem"}},
+		},
+		"itemActivated": {
+			{"ConnectItemActivated", []string{"*widgets.QTableWidgetItem"}},
+		},
+		"itemChanged": {
+			{"ConnectItemChanged", []string{"*widgets.QTableWidgetItem"}},
+		},
+		"itemClicked": {
+			{"ConnectItemClicked", []string{"*widgets.QTableWidgetItem"}},
+		},
+		"itemDoubleClicked": {
+			{"ConnectItemDoubleClicked", []string{"*widgets.QTableWidgetItem"}},
+		},
+		"itemEntered": {
+			{"ConnectItemEntered", []string{"*widgets.QTableWidgetItem"}},
+		},
+		"itemPressed": {
+			{"ConnectItemPressed", []string{"*widgets.QTableWidgetItem"}},
+		},
+		"itemSelectionChanged": {
+			{"ConnectItemSelectionChanged", []string{}},
+		},
+	},
+	"QTextBrowser": {
+		"anchorClicked": {
+			{"ConnectAnchorClicked", []string{"*core.QUrl"}},
+		},
+		"backwardAvailable": {
+			{"ConnectBackwardAvailable", []string{"bool"}},
+		},
+		"forwardAvailable": {
+			{"ConnectForwardAvailable", []string{"bool"}},
+		},
+		"highlighted": {
+			{"ConnectHighlighted", []string{"*core.QUrl"}},
+			{"ConnectHighlighted2", []string{"string"}},
+		},
+		"historyChanged": {
+			{"ConnectHistoryChanged", []string{}},
+		},
+		"sourceChanged": {
+			{"ConnectSourceChanged", []string{"*core.QUrl"}},
+		},
+	},
+	"QTextEdit": {
+		"copyAvailable": {
+			{"ConnectCopyAvailable", []string{"bool"}},
+		},
+		"currentCharFormatChanged": {
+			{"ConnectCurrentCharFormatChanged", []string{"*gui.QTextCharFormat"}},
+		},
+		"cursorPositionChanged": {
+			{"ConnectCursorPositionChanged", []string{}},
+		},
+		"redoAvailable": {
+			{"ConnectRedoAvailable", []string{"bool"}},
+		},
+		"selectionChanged": {
+			{"ConnectSelectionChanged", []string{}},
+		},
+		"textChanged": {
+			{"ConnectTextChanged", []string{}},
+		},
+		"undoAvailable": {
+			{"ConnectUndoAvailable", []string{"bool"}},
+		},
+	},
+	"QToolBar": {
+		"actionTriggered": {
+			{"ConnectActionTriggered", []string{"*widgets.QAction"}},
+		},
+		"allowedAreasChanged": {
+			{"ConnectAllowedAreasChanged", []string{"core.Qt__ToolBarArea"}},
+		},
+		"iconSizeChanged": {
+			{"ConnectIconSizeChanged", []string{"*core.QSize"}},
+		},
+		"movableChanged": {
+			{"ConnectMovableChanged", []string{"bool"}},
+		},
+		"orientationChanged": {
+			{"ConnectOrientationChanged", []string{"core.Qt__Orientation"}},
+		},
+		"toolButtonStyleChanged": {
+			{"ConnectToolButtonStyleChanged", []string{"core.Qt__ToolButtonStyle"}},
+		},
+		"topLevelChanged": {
+			{"ConnectTopLevelChanged", []string{"bool"}},
+		},
+		"visibilityChanged": {
+			{"ConnectVisibilityChanged", []string{"bool"}},
+		},
+	},
+	"QToolBox": {
+		"currentChanged": {
+			{"ConnectCurrentChanged", []string{"int"}},
+		},
+	},
+	"QToolButton": {
+		"triggered": {
+			{"ConnectTriggered", []string{"*widgets.QAction"}},
+		},
+	},
+	"QTreeView": {
+		"collapsed": {
+			{"ConnectCollapsed", []string{"*core.QModelIndex"}},
+		},
+		"expanded": {
+			{"ConnectExpanded", []string{"*core.QModelIndex"}},
+		},
+	},
+	"QTreeWidget": {
+		"currentItemChanged": {
+			{"ConnectCurrentItemChanged", []string{"*widgets.QTreeWidgetItem", "*widgets.QTreeWidgetItem"}},
+		},
+		"itemActivated": {
+			{"ConnectItemActivated", []string{"*widgets.QTreeWidgetItem", "int"}},
+		},
+		"itemChanged": {
+			{"ConnectItemChanged", []string{"*widgets.QTreeWidgetItem", "int"}},
+		},
+		"itemClicked": {
+			{"ConnectItemClicked", []string{"*widgets.QTreeWidgetItem", "int"}},
+		},
+		"itemCollapsed": {
+			{"ConnectItemCollapsed", []string{"*widgets.QTreeWidgetItem"}},
+		},
+		"itemDoubleClicked": {
+			{"ConnectItemDoubleClicked", []string{"*widgets.QTreeWidgetItem", "int"}},
+		},
+		"itemEntered": {
+			{"ConnectItemEntered", []string{"*widgets.QTreeWidgetItem", "int"}},
+		},
+		"itemExpanded": {
+			{"ConnectItemExpanded", []string{"*widgets.QTreeWidgetItem"}},
+		},
+		"itemPressed": {
+			{"ConnectItemPressed", []string{"*widgets.QTreeWidgetItem", "int"}},
+		},
+		"itemSelectionChanged": {
+			{"ConnectItemSelectionChanged", []string{}},
+		},
+	},
+	"QWidget": {
+		"customContextMenuRequested": {
+			{"ConnectCustomContextMenuRequested", []string{"*core.QPoint"}},
+		},
+		"windowIconChanged": {
+			{"ConnectWindowIconChanged", []string{"*gui.QIcon"}},
+		},
+		"windowTitleChanged": {
+			{"ConnectWindowTitleChanged", []string{"string"}},
+		},
+	},
+	"QWizard": {
+		"currentIdChanged": {
+			{"ConnectCurrentIdChanged", []string{"int"}},
+		},
+		"customButtonClicked": {
+			{"ConnectCustomButtonClicked", []string{"int"}},
+		},
+		"helpRequested": {
+			{"ConnectHelpRequested", []string{}},
+		},
+		"pageAdded": {
+			{"ConnectPageAdded", []string{"int"}},
+		},
+		"pageRemoved": {
+			{"ConnectPageRemoved", []string{"int"}},
+		},
+	},
+	"QWizardPage": {
+		"completeChanged": {
+			{"ConnectCompleteChanged", []string{}},
+		},
+	},
+}
+
+// objectClass returns the class of the widget, layout, action or group named
+// name, "" if there is none.
+func (this *compiler) objectClass(name string) string {
+	for _, group := range this.ButtonGroups {
+		if group == name {
+			return "QButtonGroup"
+		}
+	}
+
+	var layoutClass func(layout *QLayout) string
+	var widgetClass func(widget *QWidget) string
+	var actionGroupClass func(group *ActionGroup) string
+
+	actionGroupClass = func(group *ActionGroup) string {
+		if group.Name == name {
+			return "QActionGroup"
+		}
+		for _, action := range group.Actions {
+			if action.Name == name {
+				return "QAction"
+			}
+		}
+		for _, ch := range group.ActionGroups {
+			if class := actionGroupClass(ch); class != "" {
+				return class
+			}
+		}
+		return ""
+	}
+	layoutClass = func(layout *QLayout) string {
+		if layout.Name == name {
+			return layout.Class
+		}
+		for _, item := range layout.Items {
+			var class string
+			switch view := item.View.(type) {
+			case *QWidget:
+				class = widgetClass(view)
+			case *QLayout:
+				class = layoutClass(view)
+			}
+			if class != "" {
+				return class
+			}
+		}
+		return ""
+	}
+	widgetClass = func(widget *QWidget) string {
+		if widget.Name == name {
+			return widget.Class
+		}
+		for _, action := range widget.Actions {
+			if action.Name == name {
+				return "QAction"
+			}
+		}
+		for _, group := range widget.ActionsGroups {
+			if class := actionGroupClass(group); class != "" {
+				return class
+			}
+		}
+		if widget.Layout != nil {
+			if class := layoutClass(widget.Layout); class != "" {
+				return class
+			}
+		}
+		for _, ch := range widget.Widgets {
+			if class := widgetClass(ch); class != "" {
+				return class
+			}
+		}
+		return ""
+	}
+	return widgetClass(this.Widget)
+}
+
+// superClass returns the class class extends, "" if unknown.
+func (this *compiler) superClass(class string) string {
+	if cw := this.customWidget(class); cw != nil {
+		return cw.Extends
+	}
+	return ClassBases[class]
+}
+
+// signalConnector returns the Go connector of signal with the Go parameter
+// types params on an object of class and the Go parameter types of the
+// connector. The signal is looked up in Signals for class and its base
+// classes. As Qt signals with default arguments may be connected without
+// them, params may be a prefix of the connector's. Signals of custom classes
+// missing from Signals are connected with Connect<Signal>.
+func (this *compiler) signalConnector(class string, signal string, params []string) (string, []string, error) {
+	custom := class == ""
+	seen := map[string]bool{}
+	for c := class; c != "" && !seen[c]; c = this.superClass(c) {
+		seen[c] = true
+		if this.customWidget(c) != nil {
+			custom = true
+		}
+		overloads, ok := Signals[c][signal]
+		if !ok {
+			continue
+		}
+
+		for _, prefix := range []bool{false, true} {
+			for _, overload := range overloads {
+				if this.takesSignal(overload.Params, params, prefix) {
+					return overload.Connector, overload.Params, nil
+				}
+			}
+		}
+
+		connectors := make([]string, len(overloads))
+		for i, overload := range overloads {
+			connectors[i] = fmt.Sprintf("%s(%s)", overload.Connector, strings.Join(overload.Params, ", "))
+		}
+		return "", nil, fmt.Errorf("no overload of %s::%s takes (%s), have %s", c, signal,
+			strings.Join(params, ", "), strings.Join(connectors, ", "))
+	}
+	if !custom {
+		return "", nil, fmt.Errorf("unknown signal %s of %s", signal, class)
+	}
+	return "Connect" + ToCamelCase(signal), params, nil
+}
+
+// takesSignal reports whether a connector with the parameter types
+// connectorParams serves a signal with the parameter types params, either
+// exactly or, if prefix is set, with default arguments left out.
+func (this *compiler) takesSignal(connectorParams []string, params []string, prefix bool) bool {
+	if len(params) > len(connectorParams) || !prefix && len(params) != len(connectorParams) {
+		return false
+	}
+	for i, param := range params {
+		if param != connectorParams[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,128 @@
+package parser
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+var _ = Describe("TestSignatureTypes", func() {
+	It("maps C++ types to Go", func() {
+		err, compiler := NewCompiler("testdata/custom.ui")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(compiler.Parse()).To(Succeed())
+
+		name, params := compiler.parseSignature(" dataChanged ( const QModelIndex &, QModelIndex ,QVector< int > ) ")
+		Expect(name).To(Equal("dataChanged"))
+		Expect(params).To(Equal([]string{"const QModelIndex &", "QModelIndex", "QVector< int >"}))
+
+		for cppType, goType := range map[string]string{
+			"int":                               "int",
+			"bool":                              "bool",
+			"qreal":                             "float64",
+			"unsigned  int":                     "uint",
+			"QString":                           "string",
+			"const QString &":                   "string",
+			"QStringList":                       "[]string",
+			"const QModelIndex&":                "*core.QModelIndex",
+			"QModelIndex const &":               "*core.QModelIndex",
+			"QListWidgetItem *":                 "*widgets.QListWidgetItem",
+			"QColor":                            "*gui.QColor",
+			"::QAction*":                        "*widgets.QAction",
+			"Qt::Orientation":                   "core.Qt__Orientation",
+			"QSystemTrayIcon::ActivationReason": "widgets.QSystemTrayIcon__ActivationReason",
+			"QVector<int>":                      "[]int",
+			"const QList<QModelIndex> &":        "[]*core.QModelIndex",
+			"ColorButton*":                      "*colorwidgets.ColorButton",
+		} {
+			Expect(compiler.goType(cppType)).To(Equal(goType), cppType)
+		}
+
+		_, err = compiler.goType("Bogus *")
+		Expect(err).To(MatchError("cannot map C++ type Bogus * to Go"))
+		_, err = compiler.goType("int *")
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("generates connections with Go types", func() {
+		code, diagnostics := compile("testdata/signals.ui", nil)
+
+		Expect(code).To(ContainSubstring(`this.ListView.ConnectClicked(func(arg0 *core.QModelIndex) {
+		this.Label.Clear()
+	})`))
+		Expect(code).To(ContainSubstring("this.LineEdit.ConnectTextChanged(this.Label.SetText)"))
+		Expect(diagnostics).To(HavePrefix("testdata/signals.ui:26:3: signal spinBox.valueChanged(Bogus*): cannot map C++ type Bogus* to Go\n"))
+	})
+})
+
+var _ = Describe("TestSignals", func() {
+	It("matches the binding", func() {
+		for _, pkg := range bindingPackages {
+			source := bindingSource(pkg)
+
+			for class, signals := range Signals {
+				if ClassPackages[class] != pkg {
+					continue
+				}
+				for _, overloads := range signals {
+					for _, overload := range overloads {
+						// The binding does not qualify the types of its own package.
+						params := make([]string, len(overload.Params))
+						for i, param := range overload.Params {
+							params[i] = `\w+ ` + regexp.QuoteMeta(strings.Replace(param, pkg+".", "", 1))
+						}
+						Expect(source).To(MatchRegexp(`func \(ptr \*`+class+`\) `+overload.Connector+`\(f func\(`+strings.Join(params, ", ")+`\)\)`), overload.Connector)
+					}
+				}
+			}
+		}
+	})
+
+	It("picks the connector of the overload", func() {
+		code, diagnostics := compile("testdata/signals.ui", nil)
+
+		Expect(code).To(ContainSubstring("this.SpinBox.ConnectValueChanged2(this.Label.SetText)"))
+		Expect(code).To(ContainSubstring("this.SpinBox.ConnectValueChanged(this.Label.SetNum)"))
+		Expect(code).To(ContainSubstring("this.FontComboBox.ConnectActivated2(this.Label.SetText)"))
+		Expect(code).NotTo(ContainSubstring("ConnectHighlighted"))
+		Expect(diagnostics).To(ContainSubstring("testdata/signals.ui:50:3: signal fontComboBox.highlighted(bool): no overload of QComboBox::highlighted takes (bool), have ConnectHighlighted(int), ConnectHighlighted2(string)\n"))
+	})
+
+	It("checks the signal parameters", func() {
+		code, diagnostics := compile("testdata/signals.ui", nil)
+
+		Expect(code).To(ContainSubstring(`this.PushButton.ConnectClicked(func(arg0 bool) {
+		this.Label.Clear()
+	})`))
+		Expect(code).NotTo(ContainSubstring("ConnectCurrentIndexChanged"))
+		Expect(code).NotTo(ContainSubstring("ConnectBogus"))
+		Expect(diagnostics).To(ContainSubstring("testdata/signals.ui:56:3: signal fontComboBox.currentIndexChanged(QString): no overload of QComboBox::currentIndexChanged takes (string), have ConnectCurrentIndexChanged(int)\n"))
+		Expect(diagnostics).To(ContainSubstring("testdata/signals.ui:62:3: signal lineEdit.bogus(): unknown signal bogus of QLineEdit\n"))
+
+		typeCheck("testdata/signals.ui")
+	})
+})
+
+var _ = Describe("TestConnectSlotsByName", func() {
+	It("test", func() {
+		var uiCompiler *compiler
+		code, _ := compile("../sample/ui/test.ui", func(compiler *compiler) {
+			compiler.ConnectSlotsByName = true
+			uiCompiler = compiler
+		})
+		Expect(code).To(ContainSubstring(`"github.com/stephenlyu/goqtuic/autoconnect"`))
+		Expect(code).To(ContainSubstring("SetupUI(Form *widgets.QWidget, handler interface{}) error {"))
+		Expect(code).To(ContainSubstring("return autoconnect.ConnectSlotsByName(this, handler, map[string]interface{}{\"Form\": Form})\n}"))
+
+		dir, err := ioutil.TempDir("", "goqtuic")
+		Expect(err).NotTo(HaveOccurred())
+		defer os.RemoveAll(dir)
+		Expect(uiCompiler.GenerateTestCode(filepath.Join(dir, "main.go"), "")).To(Succeed())
+		data, _ := ioutil.ReadFile(filepath.Join(dir, "main.go"))
+		Expect(string(data)).To(ContainSubstring("window.SetupUI(window.Widget, window)"))
+	})
+})
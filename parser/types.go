@@ -0,0 +1,155 @@
+package parser
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+// builtinTypes maps C++ value types to the Go types therecipe uses for them.
+var builtinTypes = map[string]string{
+	"bool":               "bool",
+	"char":               "int8",
+	"signed char":        "int8",
+	"unsigned char":      "uint8",
+	"uchar":              "uint8",
+	"short":              "int16",
+	"unsigned short":     "uint16",
+	"ushort":             "uint16",
+	"int":                "int",
+	"unsigned":           "uint",
+	"unsigned int":       "uint",
+	"uint":               "uint",
+	"long":               "int",
+	"unsigned long":      "uint",
+	"ulong":              "uint",
+	"long long":          "int64",
+	"unsigned long long": "uint64",
+	"qint8":              "int8",
+	"quint8":             "uint8",
+	"qint16":             "int16",
+	"quint16":            "uint16",
+	"qint32":             "int32",
+	"quint32":            "uint32",
+	"qint64":             "int64",
+	"quint64":            "uint64",
+	"qlonglong":          "int64",
+	"qulonglong":         "uint64",
+	"float":              "float32",
+	"double":             "float64",
+	"qreal":              "float64",
+	"QString":            "string",
+	"QStringList":        "[]string",
+}
+
+// listTypes are the C++ containers therecipe maps to slices.
+var listTypes = []string{"QList", "QVector"}
+
+// goType returns the Go type therecipe uses for the C++ parameter type
+// cppType, e.g. "*core.QModelIndex" for "const QModelIndex &". const,
+// reference and pointer qualifiers and namespace prefixes are removed.
+func (this *compiler) goType(cppType string) (string, error) {
+	t := strings.TrimSpace(cppType)
+	t = strings.TrimPrefix(t, "const ")
+	t = strings.TrimSpace(strings.TrimSuffix(t, "&"))
+	pointer := strings.HasSuffix(t, "*")
+	t = strings.TrimSpace(strings.TrimSuffix(t, "*"))
+	t = strings.TrimSpace(strings.TrimSuffix(t, "const"))
+	t = strings.Join(strings.Fields(t), " ")
+
+	if goType, ok := builtinTypes[t]; ok && !pointer {
+		return goType, nil
+	}
+
+	for _, list := range listTypes {
+		if strings.HasPrefix(t, list+"<") && strings.HasSuffix(t, ">") && !pointer {
+			elem, err := this.goType(t[len(list)+1 : len(t)-1])
+			if err != nil {
+				return "", err
+			}
+			return "[]" + elem, nil
+		}
+	}
+
+	if i := strings.LastIndex(t, "::"); i >= 0 && !pointer {
+		// An enum like Qt::Orientation, or a class in a namespace.
+		scope, name := strings.TrimPrefix(t[:i], "::"), t[i+2:]
+		if _import, ok := this.enumPackage(scope); ok && this.classPackage(name) == "" {
+			return this.qualify(_import, scope+"__"+name), nil
+		}
+	}
+	if i := strings.LastIndex(t, "::"); i >= 0 {
+		t = t[i+2:]
+	}
+
+	if _import := this.classPackage(t); _import != "" {
+		return "*" + this.qualify(_import, t), nil
+	}
+	if cw := this.customWidget(t); cw != nil {
+		goType, _ := this.customWidgetType(cw)
+		return "*" + goType, nil
+	}
+	return "", fmt.Errorf("cannot map C++ type %s to Go", cppType)
+}
+
+// classPackage returns the therecipe package of class, "" if unknown.
+func (this *compiler) classPackage(class string) string {
+	return ClassPackages[class]
+}
+
+// qualify returns name qualified by the package of _import, which is
+// imported. An empty _import is the package of the generated code.
+func (this *compiler) qualify(_import string, name string) string {
+	if _import == "" {
+		return name
+	}
+	this.addImport(_import)
+	pkg := this.packageName(_import)
+	if pkg != path.Base(_import) {
+		this.addNamedImport(_import, pkg)
+	}
+	return pkg + "." + name
+}
+
+// packageName returns the name of the package _import. Unless the name is
+// given with the import as path;name, it is taken from the last element of
+// the path, without a major version suffix like /v2, lower-cased and with
+// the characters not allowed in an identifier, like - and ., dropped.
+func (this *compiler) packageName(_import string) string {
+	if !strings.Contains(_import, "/") || strings.HasPrefix(_import, "github.com/therecipe/qt/") {
+		return path.Base(_import)
+	}
+	if name, ok := this.packageNames[_import]; ok {
+		return name
+	}
+
+	name := path.Base(_import)
+	if majorVersion.MatchString(name) && path.Dir(_import) != "." {
+		name = path.Base(path.Dir(_import))
+	}
+	name = strings.Map(func(r rune) rune {
+		if r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return unicode.ToLower(r)
+		}
+		return -1
+	}, name)
+	this.packageNames[_import] = name
+	return name
+}
+
+var majorVersion = regexp.MustCompile(`^v[0-9]+$`)
+
+// goTypes maps the C++ parameter types of a signature to Go.
+func (this *compiler) goTypes(cppTypes []string) ([]string, error) {
+	ret := make([]string, len(cppTypes))
+	for i, t := range cppTypes {
+		goType, err := this.goType(t)
+		if err != nil {
+			return nil, err
+		}
+		ret[i] = goType
+	}
+	return ret, nil
+}
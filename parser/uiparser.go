@@ -1,35 +1,114 @@
 package parser
 
 import (
+	"encoding/xml"
 	"fmt"
 	xmlx "github.com/stephenlyu/go-pkg-xmlx"
-	"log"
+	"io"
+	"io/ioutil"
+	"strconv"
+	"strings"
 )
 
 type parser struct {
-	doc *xmlx.Document
+	*UI
 
-	uiFile string
-	class  string
+	doc       *xmlx.Document
+	positions map[*xmlx.Node]Position
 
-	buttonGroups  []string
-	tabStops      []string
-	layoutDefault *LayoutDefault
-	connections   []*Connection
+	errors ParseErrors
+}
+
+// ParseFile parses the .ui file uiFile.
+func ParseFile(uiFile string) (*UI, error) {
+	err, p := NewParser(uiFile)
+	if err != nil {
+		return nil, err
+	}
+	return p.parse()
+}
 
-	widget *QWidget
+// Parse parses a .ui document read from r.
+func Parse(r io.Reader) (*UI, error) {
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	return ParseBytes(data)
+}
+
+// ParseBytes parses a .ui document held in data.
+func ParseBytes(data []byte) (*UI, error) {
+	err, p := newParser("", data)
+	if err != nil {
+		return nil, err
+	}
+	return p.parse()
 }
 
 func NewParser(uiFile string) (error, *parser) {
-	ret := &parser{uiFile: uiFile}
+	data, err := ioutil.ReadFile(uiFile)
+	if err != nil {
+		return &ParseError{File: uiFile, Reason: err.Error()}, nil
+	}
+	return newParser(uiFile, data)
+}
+
+func newParser(uiFile string, data []byte) (error, *parser) {
+	ret := &parser{UI: &UI{File: uiFile}}
 	ret.doc = xmlx.New()
-	err := ret.doc.LoadFile(uiFile, nil)
+	err := ret.doc.LoadBytes(data, nil)
 	if err != nil {
-		return err, nil
+		if syntaxErr, ok := err.(*xml.SyntaxError); ok {
+			return &ParseError{File: uiFile, Line: syntaxErr.Line, Reason: syntaxErr.Msg}, nil
+		}
+		return &ParseError{File: uiFile, Reason: err.Error()}, nil
 	}
+	ret.positions = scanPositions(uiFile, data, ret.doc)
 	return nil, ret
 }
 
+func (this *parser) parse() (*UI, error) {
+	if err := this.Parse(); err != nil {
+		return nil, err
+	}
+	return this.UI, nil
+}
+
+// pos returns the source position of n.
+func (this *parser) pos(n *xmlx.Node) Position {
+	if pos, ok := this.positions[n]; ok {
+		return pos
+	}
+	return Position{File: this.File}
+}
+
+// errorf records a parse error at node n. Parsing is abandoned once
+// MaxErrors errors have been collected.
+func (this *parser) errorf(n *xmlx.Node, format string, args ...interface{}) {
+	pos := this.pos(n)
+	this.errors = append(this.errors, &ParseError{
+		File:   this.File,
+		Line:   pos.Line,
+		Column: pos.Column,
+		Path:   elementPath(n),
+		Reason: fmt.Sprintf(format, args...),
+	})
+	if len(this.errors) >= MaxErrors {
+		panic(bailout{})
+	}
+}
+
+// catchBailout stops the panic raised by errorf once MaxErrors errors have
+// been collected. It must be deferred.
+func (this *parser) catchBailout() {
+	if r := recover(); r != nil {
+		if _, ok := r.(bailout); !ok {
+			panic(r)
+		}
+	}
+}
+
 func (this *parser) parsePoint(n *xmlx.Node) *QPoint {
 	width := n.I("", "x")
 	height := n.I("", "y")
@@ -70,18 +149,48 @@ func (this *parser) parseRectF(n *xmlx.Node) *QRectF {
 	return &QRectF{x, y, width, height}
 }
 
+// optionalInt returns the value of the child element name of n, nil if there
+// is none.
+func (this *parser) optionalInt(n *xmlx.Node, name string) *int {
+	children := n.SelectNodesDirect("", name)
+	if len(children) == 0 {
+		return nil
+	}
+	v, err := strconv.Atoi(strings.TrimSpace(children[0].GetValue()))
+	if err != nil {
+		this.errorf(children[0], "bad %s value %s", name, children[0].GetValue())
+		return nil
+	}
+	return &v
+}
+
+// optionalBool returns the value of the child element name of n, nil if there
+// is none.
+func (this *parser) optionalBool(n *xmlx.Node, name string) *bool {
+	children := n.SelectNodesDirect("", name)
+	if len(children) == 0 {
+		return nil
+	}
+	v, err := strconv.ParseBool(strings.TrimSpace(children[0].GetValue()))
+	if err != nil {
+		this.errorf(children[0], "bad %s value %s", name, children[0].GetValue())
+		return nil
+	}
+	return &v
+}
+
 func (this *parser) parseFont(n *xmlx.Node) *QFont {
 	return &QFont{
 		Family:        n.S("", "family"),
-		PointSize:     n.I("", "pointsize"),
-		Weight:        n.I("", "weight"),
-		Italic:        n.B("", "bool"),
-		Bold:          n.B("", "bool"),
-		Underline:     n.B("", "bool"),
-		Strikeout:     n.B("", "bool"),
-		AntiAliasing:  n.B("", "bool"),
-		StyleStrategy: n.S("", "string"),
-		Kerning:       n.B("", "bool"),
+		PointSize:     this.optionalInt(n, "pointsize"),
+		Weight:        this.optionalInt(n, "weight"),
+		Italic:        this.optionalBool(n, "italic"),
+		Bold:          this.optionalBool(n, "bold"),
+		Underline:     this.optionalBool(n, "underline"),
+		Strikeout:     this.optionalBool(n, "strikeout"),
+		AntiAliasing:  this.optionalBool(n, "antialiasing"),
+		StyleStrategy: strings.TrimSpace(n.S("", "stylestrategy")),
+		Kerning:       this.optionalBool(n, "kerning"),
 	}
 }
 
@@ -96,10 +205,12 @@ func (this *parser) parseGradientStop(n *xmlx.Node) *GradientStop {
 }
 
 func (this *parser) parseGradient(n *xmlx.Node) *QGrdient {
-	children := n.SelectNodesDirect("", "gradientStop")
-	stops := make([]*GradientStop, len(children))
-	for i, ch := range children {
-		stops[i] = this.parseGradientStop(ch)
+	// Designer writes gradientstop, the schema gradientStop.
+	stops := []*GradientStop{}
+	for _, ch := range this.elementChildren(n) {
+		if strings.EqualFold(ch.Name.Local, "gradientStop") {
+			stops = append(stops, this.parseGradientStop(ch))
+		}
 	}
 	return &QGrdient{
 		GradientStops:  stops,
@@ -113,9 +224,9 @@ func (this *parser) parseGradient(n *xmlx.Node) *QGrdient {
 		FocalY:         n.Af64("", "focaly"),
 		Radius:         n.Af64("", "radius"),
 		Angle:          n.Af64("", "angle"),
-		Type:           n.S("", "type"),
-		Spread:         n.S("", "spread"),
-		CoordinateMode: n.S("", "coordinatemode"),
+		Type:           n.As("", "type"),
+		Spread:         n.As("", "spread"),
+		CoordinateMode: n.As("", "coordinatemode"),
 	}
 }
 
@@ -159,11 +270,21 @@ func (this *parser) parseStringList(n *xmlx.Node) *StringList {
 		strings[i] = ch.GetValue()
 	}
 
-	return &StringList{Strings: strings, NotR: n.Ab("", "notr")}
+	return &StringList{Strings: strings, NotR: n.Ab("", "notr"), Translation: this.parseTranslation(n)}
 }
 
 func (this *parser) parseString(n *xmlx.Node) *String {
-	return &String{Value: n.GetValue(), NotR: n.Ab("", "notr")}
+	return &String{Value: n.GetValue(), NotR: n.Ab("", "notr"), Translation: this.parseTranslation(n)}
+}
+
+// parseTranslation parses the translator attributes of a string or string
+// list.
+func (this *parser) parseTranslation(n *xmlx.Node) Translation {
+	return Translation{
+		Comment:      n.As("", "comment"),
+		ExtraComment: n.As("", "extracomment"),
+		Id:           n.As("", "id"),
+	}
 }
 
 func (this *parser) parseColor(n *xmlx.Node) *QColor {
@@ -176,36 +297,66 @@ func (this *parser) parseColor(n *xmlx.Node) *QColor {
 }
 
 func (this *parser) parseBrush(n *xmlx.Node) *QBrush {
-	ch := n.SelectNode("", "color")
-	return &QBrush{
-		BrushStyle: n.As("", "brushstyle"),
-		Color:      this.parseColor(ch),
+	children := this.elementChildren(n)
+	if len(children) != 1 {
+		this.errorf(n, "bad brush with %d children", len(children))
+		return nil
+	}
+
+	ret := &QBrush{BrushStyle: n.As("", "brushstyle")}
+	ch := children[0]
+	switch ch.Name.Local {
+	case "color":
+		ret.Color = this.parseColor(ch)
+	case "gradient":
+		ret.Gradient = this.parseGradient(ch)
+	case "texture":
+		ret.Texture = this.parseProperty(ch)
+		if ret.Texture == nil {
+			return nil
+		}
+	default:
+		this.errorf(ch, "bad brush child type %s", ch.Name.Local)
+		return nil
 	}
+	return ret
 }
 
 func (this *parser) parseColorRole(n *xmlx.Node) *QColorRole {
 	ch := n.SelectNode("", "brush")
+	if ch == nil {
+		this.errorf(n, "color role %s has no brush", n.As("", "role"))
+		return nil
+	}
+	brush := this.parseBrush(ch)
+	if brush == nil {
+		return nil
+	}
 	return &QColorRole{
 		Role:  n.As("", "role"),
-		Brush: this.parseBrush(ch),
+		Brush: brush,
 	}
 }
 
 func (this *parser) parseColorGroup(nodes []*xmlx.Node) *ColorGroup {
-	items := make([]*ColorGroupItem, len(nodes))
-	for i, n := range nodes {
+	items := []*ColorGroupItem{}
+	for _, n := range nodes {
 		if n.Name.Local == "color" {
-			items[i] = &ColorGroupItem{
+			items = append(items, &ColorGroupItem{
 				IsColor: true,
 				Color:   this.parseColor(n),
-			}
+			})
 		} else if n.Name.Local == "colorrole" {
-			items[i] = &ColorGroupItem{
-				IsColor:   false,
-				ColorRole: this.parseColorRole(n),
+			colorRole := this.parseColorRole(n)
+			if colorRole == nil {
+				continue
 			}
+			items = append(items, &ColorGroupItem{
+				IsColor:   false,
+				ColorRole: colorRole,
+			})
 		} else {
-			log.Fatalf("bad color group %s", n)
+			this.errorf(n, "bad color group child type %s", n.Name.Local)
 		}
 	}
 	return &ColorGroup{Items: items}
@@ -216,17 +367,24 @@ func (this *parser) parsePalette(n *xmlx.Node) *QPalette {
 
 	childCount := len(this.elementChildren(n))
 	if childCount != 3 {
-		log.Fatalf("Bad palette with %d children", childCount)
+		this.errorf(n, "bad palette with %d children", childCount)
+		return nil
 	}
 
 	activeNode := n.SelectNode("", "active")
-	ret.Active = this.parseColorGroup(this.elementChildren(activeNode))
+	if activeNode != nil {
+		ret.Active = this.parseColorGroup(this.elementChildren(activeNode))
+	}
 
 	inActiveNode := n.SelectNode("", "inactive")
-	ret.InActive = this.parseColorGroup(this.elementChildren(inActiveNode))
+	if inActiveNode != nil {
+		ret.InActive = this.parseColorGroup(this.elementChildren(inActiveNode))
+	}
 
 	disabledNode := n.SelectNode("", "disabled")
-	ret.Disabled = this.parseColorGroup(this.elementChildren(disabledNode))
+	if disabledNode != nil {
+		ret.Disabled = this.parseColorGroup(this.elementChildren(disabledNode))
+	}
 
 	return ret
 }
@@ -242,19 +400,25 @@ func (this *parser) parserIconSet(n *xmlx.Node) *QIcon {
 		SelectedOff: n.S("", "selectedoff"),
 		SelectedOn:  n.S("", "selectedon"),
 		Theme:       n.As("", "theme"),
+		Resource:    n.As("", "resource"),
 	}
 }
 
 func (this *parser) parseAttribute(n *xmlx.Node) *Attribute {
 	var value interface{}
 
-	ch := this.elementChildren(n)[0]
-
 	name := n.As("", "name")
 
+	children := this.elementChildren(n)
+	if len(children) != 1 {
+		this.errorf(n, "bad attribute %s with %d children", name, len(children))
+		return nil
+	}
+	ch := children[0]
+
 	switch ch.Name.Local {
 	case "string":
-		value = &String{Value: n.S("", "string"), NotR: ch.Ab("", "notr")}
+		value = this.parseString(ch)
 	case "bool":
 		value = n.B("", "bool")
 	case "number":
@@ -263,11 +427,14 @@ func (this *parser) parseAttribute(n *xmlx.Node) *Attribute {
 		value = n.F64("", "double")
 	case "enum":
 		value = this.parseEnum(ch)
+	case "iconset":
+		value = this.parserIconSet(ch)
 	default:
-		log.Fatalf("Bad attribute type %s of %s", ch.Name.Local, name)
+		this.errorf(ch, "bad attribute type %s of %s", ch.Name.Local, name)
+		return nil
 	}
 
-	return &Attribute{Name: name, Value: value}
+	return &Attribute{Pos: this.pos(n), Name: name, Value: value}
 }
 
 func (this *parser) parseSizePolicy(n *xmlx.Node) *QSizePolicy {
@@ -294,15 +461,12 @@ func (this *parser) parseLayoutItem(n *xmlx.Node) *QLayoutItem {
 	column := n.Ai("", "column")
 	rowSpan := n.Ai("", "rowspan")
 	colSpan := n.Ai("", "colspan")
-	alignment := n.S("", "alignment")
+	alignment := n.As("", "alignment")
 
 	children := this.elementChildren(n)
 	if len(children) != 1 {
-		for _, ch := range children {
-			fmt.Println(ch)
-		}
-
-		log.Fatalf("Bad layout item")
+		this.errorf(n, "bad layout item with %d children", len(children))
+		return nil
 	}
 
 	var view interface{}
@@ -316,9 +480,11 @@ func (this *parser) parseLayoutItem(n *xmlx.Node) *QLayoutItem {
 	case "widget":
 		view = this.parseWidget(child)
 	default:
-		log.Fatalf("Bad layout item child type %s", child.Name.Local)
+		this.errorf(child, "bad layout item child type %s", child.Name.Local)
+		return nil
 	}
 	return &QLayoutItem{
+		Pos:       this.pos(n),
 		Row:       row,
 		Column:    column,
 		Rowspan:   rowSpan,
@@ -331,34 +497,39 @@ func (this *parser) parseLayoutItem(n *xmlx.Node) *QLayoutItem {
 func (this *parser) parseSpacer(n *xmlx.Node) *QSpacer {
 	name := n.As("", "name")
 	children := this.elementChildren(n)
-	properties := make([]*Property, len(children))
+	properties := []*Property{}
 
-	for i, ch := range children {
+	for _, ch := range children {
 		if ch.Name.Local != "property" {
-			log.Fatalf("Bad child type %s of spacer", ch.Name.Local)
+			this.errorf(ch, "bad child type %s of spacer", ch.Name.Local)
+			continue
 		}
 
-		properties[i] = this.parseProperty(ch)
+		if prop := this.parseProperty(ch); prop != nil {
+			properties = append(properties, prop)
+		}
 	}
 
-	return &QSpacer{Name: name, Properties: properties}
+	return &QSpacer{Pos: this.pos(n), Name: name, Properties: properties}
 }
 
-func (this *parser) parseRow(n *xmlx.Node) *Row {
-	children := n.SelectNodesDirect("", "property")
-	props := make([]*Property, len(children))
-	for i, ch := range children {
-		props[i] = this.parseProperty(ch)
+func (this *parser) parseProperties(nodes []*xmlx.Node) []*Property {
+	props := []*Property{}
+	for _, ch := range nodes {
+		if prop := this.parseProperty(ch); prop != nil {
+			props = append(props, prop)
+		}
 	}
+	return props
+}
+
+func (this *parser) parseRow(n *xmlx.Node) *Row {
+	props := this.parseProperties(n.SelectNodesDirect("", "property"))
 	return &Row{Props: props}
 }
 
 func (this *parser) parseColumn(n *xmlx.Node) *Column {
-	children := n.SelectNodesDirect("", "property")
-	props := make([]*Property, len(children))
-	for i, ch := range children {
-		props[i] = this.parseProperty(ch)
-	}
+	props := this.parseProperties(n.SelectNodesDirect("", "property"))
 	return &Column{Props: props}
 }
 
@@ -380,17 +551,24 @@ func (this *parser) parseLayout(n *xmlx.Node) *QLayout {
 	for _, ch := range children {
 		switch ch.Name.Local {
 		case "property":
-			properties = append(properties, this.parseProperty(ch))
+			if prop := this.parseProperty(ch); prop != nil {
+				properties = append(properties, prop)
+			}
 		case "item":
-			items = append(items, this.parseLayoutItem(ch))
+			if item := this.parseLayoutItem(ch); item != nil {
+				items = append(items, item)
+			}
 		case "attribute":
-			attributes = append(attributes, this.parseProperty(ch))
+			if attr := this.parseProperty(ch); attr != nil {
+				attributes = append(attributes, attr)
+			}
 		default:
-			log.Fatalf("Bad child type %s of layout", ch.Name.Local)
+			this.errorf(ch, "bad child type %s of layout", ch.Name.Local)
 		}
 	}
 
 	return &QLayout{
+		Pos:                this.pos(n),
 		Class:              class,
 		Name:               name,
 		Stretch:            stretch,
@@ -405,11 +583,7 @@ func (this *parser) parseLayout(n *xmlx.Node) *QLayout {
 }
 
 func (this *parser) parseWidgetItem(n *xmlx.Node) *QWidgetItem {
-	propNodes := n.SelectNodesDirect("", "property")
-	props := make([]*Property, len(propNodes))
-	for i, ch := range propNodes {
-		props[i] = this.parseProperty(ch)
-	}
+	props := this.parseProperties(n.SelectNodesDirect("", "property"))
 
 	itemNodes := n.SelectNodesDirect("", "item")
 	items := make([]*QWidgetItem, len(itemNodes))
@@ -418,6 +592,7 @@ func (this *parser) parseWidgetItem(n *xmlx.Node) *QWidgetItem {
 	}
 
 	return &QWidgetItem{
+		Pos:    this.pos(n),
 		Props:  props,
 		Items:  items,
 		Row:    n.Ai("", "row"),
@@ -426,19 +601,12 @@ func (this *parser) parseWidgetItem(n *xmlx.Node) *QWidgetItem {
 }
 
 func (this *parser) parseAction(n *xmlx.Node) *Action {
-	propNodes := n.SelectNodesDirect("", "property")
-	props := make([]*Property, len(propNodes))
-	for i, ch := range propNodes {
-		props[i] = this.parseProperty(ch)
-	}
+	props := this.parseProperties(n.SelectNodesDirect("", "property"))
 
-	attrNodes := n.SelectNodesDirect("", "attribute")
-	attrs := make([]*Property, len(attrNodes))
-	for i, ch := range attrNodes {
-		attrs[i] = this.parseProperty(ch)
-	}
+	attrs := this.parseProperties(n.SelectNodesDirect("", "attribute"))
 
 	return &Action{
+		Pos:        this.pos(n),
 		Props:      props,
 		Attributes: attrs,
 		Name:       n.As("", "name"),
@@ -447,17 +615,9 @@ func (this *parser) parseAction(n *xmlx.Node) *Action {
 }
 
 func (this *parser) parseActionGroup(n *xmlx.Node) *ActionGroup {
-	propNodes := n.SelectNodesDirect("", "property")
-	props := make([]*Property, len(propNodes))
-	for i, ch := range propNodes {
-		props[i] = this.parseProperty(ch)
-	}
+	props := this.parseProperties(n.SelectNodesDirect("", "property"))
 
-	attrNodes := n.SelectNodesDirect("", "attribute")
-	attrs := make([]*Property, len(attrNodes))
-	for i, ch := range attrNodes {
-		attrs[i] = this.parseProperty(ch)
-	}
+	attrs := this.parseProperties(n.SelectNodesDirect("", "attribute"))
 
 	actionNodes := n.SelectNodesDirect("", "action")
 	actions := make([]*Action, len(actionNodes))
@@ -471,6 +631,7 @@ func (this *parser) parseActionGroup(n *xmlx.Node) *ActionGroup {
 		actionGroups[i] = this.parseActionGroup(ch)
 	}
 	return &ActionGroup{
+		Pos:          this.pos(n),
 		Props:        props,
 		Attributes:   attrs,
 		Actions:      actions,
@@ -481,7 +642,7 @@ func (this *parser) parseActionGroup(n *xmlx.Node) *ActionGroup {
 }
 
 func (this *parser) parseActionRef(n *xmlx.Node) *ActionRef {
-	return &ActionRef{Name: n.As("", "name")}
+	return &ActionRef{Pos: this.pos(n), Name: n.As("", "name")}
 }
 
 func (this *parser) parseWidget(n *xmlx.Node) *QWidget {
@@ -506,9 +667,13 @@ func (this *parser) parseWidget(n *xmlx.Node) *QWidget {
 	for _, ch := range children {
 		switch ch.Name.Local {
 		case "property":
-			properties = append(properties, this.parseProperty(ch))
+			if prop := this.parseProperty(ch); prop != nil {
+				properties = append(properties, prop)
+			}
 		case "attribute":
-			attributes = append(attributes, this.parseAttribute(ch))
+			if attr := this.parseAttribute(ch); attr != nil {
+				attributes = append(attributes, attr)
+			}
 
 		case "row":
 			rows = append(rows, this.parseRow(ch))
@@ -530,21 +695,18 @@ func (this *parser) parseWidget(n *xmlx.Node) *QWidget {
 		case "zorder":
 			zorders = append(zorders, ch.GetValue())
 		default:
-			log.Fatalf("Bad child type %s of layout, parent name: %s", ch.Name.Local, name)
+			this.errorf(ch, "bad child type %s of widget", ch.Name.Local)
 		}
 	}
 
-	if len(attributes) > 0 {
-		log.Printf("widget name: %s has %d attributes", name, len(attributes))
-	}
-
 	if layout != nil {
 		if len(widgets) > 0 {
-			log.Fatalf("MUST no child if layout set. widget name: %s", name)
+			this.errorf(n, "widget with a layout must not have direct child widgets")
 		}
 	}
 
 	return &QWidget{
+		Pos:        this.pos(n),
 		Class:      class,
 		Name:       name,
 		Properties: properties,
@@ -563,12 +725,32 @@ func (this *parser) parseWidget(n *xmlx.Node) *QWidget {
 	}
 }
 
+func (this *parser) parseCustomWidget(n *xmlx.Node) *CustomWidget {
+	ret := &CustomWidget{
+		Pos:           this.pos(n),
+		Class:         n.S("", "class"),
+		Extends:       n.S("", "extends"),
+		Container:     n.I("", "container") != 0,
+		AddPageMethod: n.S("", "addpagemethod"),
+	}
+	header := n.SelectNode("", "header")
+	if header != nil {
+		ret.Header = header.GetValue()
+		ret.HeaderLocation = header.As("", "location")
+	}
+	if ret.Class == "" {
+		this.errorf(n, "custom widget without class")
+	}
+	return ret
+}
+
 func (this *parser) parseProperty(n *xmlx.Node) *Property {
 	name := n.As("", "name")
 	children := this.elementChildren(n)
 
 	if len(children) != 1 {
-		log.Fatalf("Bad property %s", name)
+		this.errorf(n, "bad property %s with %d children", name, len(children))
+		return nil
 	}
 	var value interface{}
 	child := children[0]
@@ -592,9 +774,13 @@ func (this *parser) parseProperty(n *xmlx.Node) *Property {
 	case "iconset":
 		value = this.parserIconSet(child)
 	case "pixmap":
-		value = &QPixmap{Value: n.S("", "pixmap")}
+		value = &QPixmap{Value: n.S("", "pixmap"), Resource: child.As("", "resource")}
 	case "palette":
-		value = this.parsePalette(child)
+		palette := this.parsePalette(child)
+		if palette == nil {
+			return nil
+		}
+		value = palette
 	case "point":
 		value = this.parsePoint(child)
 	case "rect":
@@ -618,7 +804,6 @@ func (this *parser) parseProperty(n *xmlx.Node) *Property {
 	case "double":
 		value = n.F64("", "double")
 	case "date":
-		fmt.Println(child)
 		value = this.parseDate(child)
 	case "time":
 		value = this.parseTime(child)
@@ -639,30 +824,57 @@ func (this *parser) parseProperty(n *xmlx.Node) *Property {
 	case "ulonglong":
 		value = n.U64("", "ulonglong")
 	case "brush":
-		value = this.parseBrush(child)
+		brush := this.parseBrush(child)
+		if brush == nil {
+			return nil
+		}
+		value = brush
 	default:
-		log.Fatalf("Bad property type %s of %v", child.Name.Local, child)
+		this.errorf(child, "bad property type %s of %s", child.Name.Local, name)
+		return nil
 	}
-	return &Property{Name: name, Value: value, StdSet: n.Ab("", "stdset")}
+	return &Property{Pos: this.pos(n), Name: name, Value: value, StdSet: n.As("", "stdset") != "0"}
 }
 
-func (this *parser) Parse() error {
+// Parse parses the ui file. Problems found in the file are reported as a
+// ParseErrors list holding up to MaxErrors entries.
+func (this *parser) Parse() (err error) {
+	this.UI = &UI{File: this.File}
+	this.errors = nil
+	defer func() {
+		if len(this.errors) > 0 {
+			err = this.errors
+		}
+	}()
+	defer this.catchBailout()
+
 	rootNode := this.doc.Root
-	this.class = rootNode.S("", "class")
+	this.Class = rootNode.S("", "class")
 
 	widgetRoot := rootNode.SelectNode("", "widget")
-	this.widget = this.parseWidget(widgetRoot)
+	if widgetRoot == nil {
+		this.errorf(rootNode, "no root widget")
+		return
+	}
+	this.Widget = this.parseWidget(widgetRoot)
 
-	layoutDefault := rootNode.SelectNode("", "layoutDefault")
+	layoutDefault := rootNode.SelectNode("", "layoutdefault")
+	if layoutDefault == nil {
+		layoutDefault = rootNode.SelectNode("", "layoutDefault")
+	}
 	if layoutDefault != nil {
-		this.layoutDefault = &LayoutDefault{Margin: layoutDefault.Ai("", "margin"), Spacing: layoutDefault.Ai("", "spacing")}
+		this.LayoutDefault = &LayoutDefault{Margin: layoutDefault.Ai("", "margin"), Spacing: layoutDefault.Ai("", "spacing")}
+	}
+	layoutFunction := rootNode.SelectNode("", "layoutfunction")
+	if layoutFunction != nil {
+		this.LayoutFunction = &LayoutFunction{Margin: layoutFunction.As("", "margin"), Spacing: layoutFunction.As("", "spacing")}
 	}
 
 	// Parse tabstops
 	tabStopsRoot := rootNode.SelectNode("", "tabstops")
 	if tabStopsRoot != nil {
 		for _, ch := range tabStopsRoot.SelectNodesDirect("", "tabstop") {
-			this.tabStops = append(this.tabStops, ch.GetValue())
+			this.TabStops = append(this.TabStops, ch.GetValue())
 		}
 	}
 
@@ -670,7 +882,7 @@ func (this *parser) Parse() error {
 	buttonGroupsRoot := rootNode.SelectNode("", "buttongroups")
 	if buttonGroupsRoot != nil {
 		for _, ch := range buttonGroupsRoot.SelectNodesDirect("", "buttongroup") {
-			this.buttonGroups = append(this.buttonGroups, ch.As("", "name"))
+			this.ButtonGroups = append(this.ButtonGroups, ch.As("", "name"))
 		}
 	}
 
@@ -678,14 +890,31 @@ func (this *parser) Parse() error {
 	connectionsRoot := rootNode.SelectNode("", "connections")
 	if connectionsRoot != nil {
 		for _, ch := range connectionsRoot.SelectNodesDirect("", "connection") {
-			this.connections = append(this.connections, &Connection{
-				ch.S("", "sender"),
-				ch.S("", "signal"),
-				ch.S("", "receiver"),
-				ch.S("", "slot"),
+			this.Connections = append(this.Connections, &Connection{
+				Pos:      this.pos(ch),
+				Sender:   ch.S("", "sender"),
+				Signal:   ch.S("", "signal"),
+				Receiver: ch.S("", "receiver"),
+				Slot:     ch.S("", "slot"),
 			})
 		}
 	}
 
-	return nil
+	// Parse custom widgets
+	customWidgetsRoot := rootNode.SelectNode("", "customwidgets")
+	if customWidgetsRoot != nil {
+		for _, ch := range customWidgetsRoot.SelectNodesDirect("", "customwidget") {
+			this.CustomWidgets = append(this.CustomWidgets, this.parseCustomWidget(ch))
+		}
+	}
+
+	// Parse resources
+	resourcesRoot := rootNode.SelectNode("", "resources")
+	if resourcesRoot != nil {
+		for _, ch := range resourcesRoot.SelectNodesDirect("", "include") {
+			this.Resources = append(this.Resources, &Resource{Pos: this.pos(ch), Location: ch.As("", "location")})
+		}
+	}
+
+	return
 }
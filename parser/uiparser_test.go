@@ -1,8 +1,10 @@
 package parser
 
 import (
+	"bytes"
 	"fmt"
 	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
 	"io/ioutil"
 	"path/filepath"
 )
@@ -14,7 +16,7 @@ var _ = Describe("TestParser", func() {
 			panic(err)
 		}
 
-		compiler.Parse()
+		Expect(compiler.Parse()).To(Succeed())
 		compiler.GenerateCode("main", "test/test_ui/test_ui.go")
 		compiler.GenerateTestCode("test/test_ui/main.go", "")
 	})
@@ -27,7 +29,7 @@ var _ = Describe("TestParseMW", func() {
 			panic(err)
 		}
 
-		compiler.Parse()
+		Expect(compiler.Parse()).To(Succeed())
 		compiler.GenerateCode("main", "test/test_main_window_ui/test_main_window_ui.go")
 		compiler.GenerateTestCode("test/test_main_window_ui/main.go", "")
 	})
@@ -40,12 +42,107 @@ var _ = Describe("TestConnection", func() {
 			panic(err)
 		}
 
-		compiler.Parse()
+		Expect(compiler.Parse()).To(Succeed())
 		compiler.GenerateCode("main", "test/test_signal_slot_ui/test_signal_slot_ui.go")
 		compiler.GenerateTestCode("test/test_signal_slot_ui/main.go", "")
 	})
 })
 
+var _ = Describe("TestParseError", func() {
+	It("test", func() {
+		err, p := NewParser("testdata/bad.ui")
+		Expect(err).NotTo(HaveOccurred())
+
+		err = p.Parse()
+		Expect(err).To(HaveOccurred())
+
+		errs, ok := err.(ParseErrors)
+		Expect(ok).To(BeTrue())
+		Expect(errs).To(HaveLen(3))
+		Expect(errs[0].File).To(Equal("testdata/bad.ui"))
+		Expect(errs[0].Path).To(Equal("ui/widget[Dialog]/property[geometry]/unknown"))
+		Expect(errs[1].Path).To(Equal("ui/widget[Dialog]/layout[verticalLayout]/item"))
+		Expect(errs[2].Path).To(Equal("ui/widget[Dialog]/layout[verticalLayout]/item/widget[label3]/property[text]"))
+
+		Expect(errs[0].Error()).To(Equal("testdata/bad.ui:6:4: ui/widget[Dialog]/property[geometry]/unknown: bad property type unknown of geometry"))
+		Expect(errs[1].Error()).To(Equal("testdata/bad.ui:9:4: ui/widget[Dialog]/layout[verticalLayout]/item: bad layout item with 2 children"))
+		// The column counts the characters of the multibyte tool tip before the
+		// property, not its bytes.
+		Expect(errs[2].Error()).To(Equal("testdata/bad.ui:15:61: ui/widget[Dialog]/layout[verticalLayout]/item/widget[label3]/property[text]: bad property text with 0 children"))
+	})
+
+	It("stops after MaxErrors", func() {
+		saved := MaxErrors
+		MaxErrors = 2
+		defer func() { MaxErrors = saved }()
+
+		_, p := NewParser("testdata/bad.ui")
+		err := p.Parse()
+		Expect(err).To(HaveLen(2))
+	})
+
+	It("reports load errors", func() {
+		err, _ := NewParser("testdata/missing.ui")
+		Expect(err).To(BeAssignableToTypeOf(&ParseError{}))
+	})
+})
+
+var _ = Describe("TestParseAPI", func() {
+	It("parses files", func() {
+		ui, err := ParseFile("testdata/custom.ui")
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(ui.File).To(Equal("testdata/custom.ui"))
+		Expect(ui.Class).To(Equal("MainWindow"))
+		Expect(ui.Widget.Class).To(Equal("QMainWindow"))
+		Expect(ui.Widget.Widgets[0].Layout.Items).To(HaveLen(2))
+
+		Expect(ui.CustomWidgets).To(HaveLen(2))
+		Expect(ui.CustomWidgets[0].Class).To(Equal("ColorButton"))
+		Expect(ui.CustomWidgets[0].Extends).To(Equal("QPushButton"))
+		Expect(ui.CustomWidgets[0].Header).To(Equal("github.com/example/colorwidgets"))
+		Expect(ui.CustomWidgets[0].Container).To(BeFalse())
+		Expect(ui.CustomWidgets[1].HeaderLocation).To(Equal("global"))
+		Expect(ui.CustomWidgets[1].Container).To(BeTrue())
+		Expect(ui.CustomWidgets[1].AddPageMethod).To(Equal("addPage"))
+
+		Expect(ui.Resources).To(HaveLen(1))
+		Expect(ui.Resources[0].Location).To(Equal("custom.qrc"))
+	})
+
+	It("parses readers and bytes", func() {
+		data, err := ioutil.ReadFile("../sample/ui/test_signal_slot.ui")
+		Expect(err).NotTo(HaveOccurred())
+
+		ui, err := Parse(bytes.NewReader(data))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ui.File).To(BeEmpty())
+		Expect(ui.Connections).NotTo(BeEmpty())
+
+		_, err = ParseBytes([]byte(`<ui version="4.0"><class>Form</class></ui>`))
+		Expect(err).To(MatchError("no root widget"))
+	})
+})
+
+var _ = Describe("TestDiagnostics", func() {
+	It("test", func() {
+		err, compiler := NewCompiler("testdata/diagnostics.ui")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(compiler.Parse()).To(Succeed())
+
+		Expect(compiler.Widget.Pos).To(Equal(Position{File: "testdata/diagnostics.ui", Line: 4, Column: 2}))
+		Expect(compiler.Connections[0].Pos.Line).To(Equal(16))
+
+		buf := &bytes.Buffer{}
+		compiler.Diagnostics = buf
+		goFile := "test/diagnostics_ui/diagnostics_ui.go"
+		Expect(compiler.GenerateCode("main", goFile)).To(MatchError("testdata/diagnostics.ui: 1 error(s), no code generated"))
+		Expect(buf.String()).To(ContainSubstring("testdata/diagnostics.ui:9:4: unknown enum Bogus::Value\n"))
+		Expect(goFile).NotTo(BeAnExistingFile())
+		Expect(generate("testdata/diagnostics.ui")).NotTo(ContainSubstring("SetOrientation"))
+	})
+})
+
 var _ = XDescribe("TestMoreParser", func() {
 	It("test", func() {
 		root := "../ui"
@@ -71,8 +168,8 @@ var _ = XDescribe("TestMoreParser", func() {
 
 			compiler.Parse()
 
-			if len(compiler.buttonGroups) > 0 {
-				fmt.Println("button group count: ", len(compiler.buttonGroups))
+			if len(compiler.ButtonGroups) > 0 {
+				fmt.Println("button group count: ", len(compiler.ButtonGroups))
 			}
 		}
 	})
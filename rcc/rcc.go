@@ -0,0 +1,145 @@
+// Package rcc builds Qt binary resource data, the format written by Qt's rcc
+// tool, so that resources embedded in Go programs can be registered with the
+// Qt resource system. The Go files generated by "goqtuic qrc" use it.
+package rcc
+
+import (
+	"bytes"
+	"encoding/binary"
+	"sort"
+	"strings"
+	"unicode/utf16"
+)
+
+const (
+	version = 1
+
+	flagDirectory = 0x02
+
+	languageC  = 1 // QLocale::C
+	anyCountry = 0 // QLocale::AnyCountry
+)
+
+// File is a file in the resource tree.
+type File struct {
+	Path string // resource path, e.g. "/images/ok.png"
+	Lang string // locale of the file, as in the qresource lang attribute, "" for any
+	Data []byte
+}
+
+// LocaleFunc returns the QLocale::Language and QLocale::Country values of a
+// locale name like "fr" or "pt_BR".
+type LocaleFunc func(lang string) (language int, country int)
+
+type node struct {
+	name     string
+	hash     uint32
+	file     *File
+	children []*node
+
+	index int // index in the tree
+}
+
+func (this *node) child(name string) *node {
+	for _, ch := range this.children {
+		if ch.file == nil && ch.name == name {
+			return ch
+		}
+	}
+	ch := &node{name: name, hash: qtHash(name)}
+	this.children = append(this.children, ch)
+	return ch
+}
+
+// qtHash is the hash function Qt uses to look up resource names.
+func qtHash(s string) uint32 {
+	var h uint32
+	for _, c := range utf16.Encode([]rune(s)) {
+		h = (h << 4) + uint32(c)
+		h ^= (h & 0xf0000000) >> 23
+		h &= 0x0fffffff
+	}
+	return h
+}
+
+// Encode returns the binary resource data of files. locale resolves the Lang
+// of files; it may be nil if no file has a Lang.
+func Encode(files []*File, locale LocaleFunc) []byte {
+	root := &node{}
+	for _, file := range files {
+		parts := strings.Split(strings.Trim(file.Path, "/"), "/")
+		dir := root
+		for _, part := range parts[:len(parts)-1] {
+			if part != "" {
+				dir = dir.child(part)
+			}
+		}
+		name := parts[len(parts)-1]
+		dir.children = append(dir.children, &node{name: name, hash: qtHash(name), file: file})
+	}
+
+	// Lay out the tree breadth first, the children of a directory are
+	// consecutive and sorted by hash for Qt's binary search.
+	nodes := []*node{root}
+	for i := 0; i < len(nodes); i++ {
+		children := nodes[i].children
+		sort.SliceStable(children, func(a, b int) bool { return children[a].hash < children[b].hash })
+		for _, ch := range children {
+			ch.index = len(nodes)
+			nodes = append(nodes, ch)
+		}
+	}
+
+	tree := &bytes.Buffer{}
+	names := &bytes.Buffer{}
+	payloads := &bytes.Buffer{}
+	nameOffsets := map[string]int{}
+
+	for _, n := range nodes {
+		nameOffset, ok := nameOffsets[n.name]
+		if !ok && n != root {
+			nameOffset = names.Len()
+			nameOffsets[n.name] = nameOffset
+			chars := utf16.Encode([]rune(n.name))
+			binary.Write(names, binary.BigEndian, uint16(len(chars)))
+			binary.Write(names, binary.BigEndian, n.hash)
+			binary.Write(names, binary.BigEndian, chars)
+		}
+		binary.Write(tree, binary.BigEndian, uint32(nameOffset))
+
+		if n.file == nil {
+			firstChild := 0
+			if len(n.children) > 0 {
+				firstChild = n.children[0].index
+			}
+			binary.Write(tree, binary.BigEndian, uint16(flagDirectory))
+			binary.Write(tree, binary.BigEndian, uint32(len(n.children)))
+			binary.Write(tree, binary.BigEndian, uint32(firstChild))
+			continue
+		}
+
+		language, country := languageC, anyCountry
+		if n.file.Lang != "" && locale != nil {
+			language, country = locale(n.file.Lang)
+		}
+		binary.Write(tree, binary.BigEndian, uint16(0))
+		binary.Write(tree, binary.BigEndian, uint16(country))
+		binary.Write(tree, binary.BigEndian, uint16(language))
+		binary.Write(tree, binary.BigEndian, uint32(payloads.Len()))
+
+		binary.Write(payloads, binary.BigEndian, uint32(len(n.file.Data)))
+		payloads.Write(n.file.Data)
+	}
+
+	const headerSize = 20
+	out := &bytes.Buffer{}
+	out.WriteString("qres")
+	binary.Write(out, binary.BigEndian, uint32(version))
+	binary.Write(out, binary.BigEndian, uint32(headerSize))
+	binary.Write(out, binary.BigEndian, uint32(headerSize+tree.Len()))
+	binary.Write(out, binary.BigEndian, uint32(headerSize+tree.Len()+payloads.Len()))
+	out.Write(tree.Bytes())
+	out.Write(payloads.Bytes())
+	out.Write(names.Bytes())
+	return out.Bytes()
+}
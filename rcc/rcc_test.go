@@ -0,0 +1,88 @@
+package rcc
+
+import (
+	"encoding/binary"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"strings"
+	"unicode/utf16"
+)
+
+// lookup finds path in resource data like QResourceRoot::findNode, and
+// returns the file data and its locale.
+func lookup(data []byte, path string) (payload []byte, language int, country int, ok bool) {
+	u32 := func(b []byte, i int) int { return int(binary.BigEndian.Uint32(b[i:])) }
+	u16 := func(b []byte, i int) int { return int(binary.BigEndian.Uint16(b[i:])) }
+
+	tree, payloads, names := data[u32(data, 8):], data[u32(data, 12):], data[u32(data, 16):]
+	name := func(node int) string {
+		offset := u32(tree, node*14)
+		chars := make([]uint16, u16(names, offset))
+		for i := range chars {
+			chars[i] = uint16(u16(names, offset+6+2*i))
+		}
+		return string(utf16.Decode(chars))
+	}
+
+	count, child := u32(tree, 6), u32(tree, 10)
+	for _, segment := range strings.Split(strings.Trim(path, "/"), "/") {
+		found := false
+		for node := child; node < child+count; node++ {
+			if name(node) != segment {
+				continue
+			}
+			if node > child && u32(names, u32(tree, (node-1)*14)+2) > u32(names, u32(tree, node*14)+2) {
+				return nil, 0, 0, false // not sorted by hash
+			}
+			offset := node * 14
+			if u16(tree, offset+4)&flagDirectory == 0 {
+				payload := payloads[u32(tree, offset+10):]
+				return payload[4 : 4+u32(payload, 0)], u16(tree, offset+8), u16(tree, offset+6), true
+			}
+			count, child = u32(tree, offset+6), u32(tree, offset+10)
+			found = true
+			break
+		}
+		if !found {
+			return nil, 0, 0, false
+		}
+	}
+	return nil, 0, 0, false
+}
+
+var _ = Describe("TestEncode", func() {
+	It("test", func() {
+		files := []*File{
+			{Path: "/images/ok.png", Data: []byte("ok")},
+			{Path: "/images/cancel.png", Data: []byte("cancel")},
+			{Path: "/images/icons/app.png", Data: []byte("app")},
+			{Path: "/i18n/app.qm", Lang: "fr", Data: []byte("bonjour")},
+			{Path: "/readme.txt", Data: []byte("")},
+		}
+		data := Encode(files, func(lang string) (int, int) {
+			Expect(lang).To(Equal("fr"))
+			return 37, 74
+		})
+		Expect(string(data[:4])).To(Equal("qres"))
+
+		for _, file := range files {
+			payload, language, country, ok := lookup(data, file.Path)
+			Expect(ok).To(BeTrue(), file.Path)
+			Expect(payload).To(Equal(file.Data))
+			if file.Lang != "" {
+				Expect([]int{language, country}).To(Equal([]int{37, 74}))
+			} else {
+				Expect([]int{language, country}).To(Equal([]int{languageC, anyCountry}))
+			}
+		}
+
+		_, _, _, ok := lookup(data, "/images/missing.png")
+		Expect(ok).To(BeFalse())
+	})
+
+	It("hashes like qt_hash", func() {
+		Expect(qtHash("")).To(Equal(uint32(0)))
+		Expect(qtHash("a")).To(Equal(uint32('a')))
+		Expect(qtHash("ab")).To(Equal(uint32('a'<<4 + 'b')))
+	})
+})
@@ -0,0 +1,95 @@
+package rcc
+
+import (
+	"errors"
+	"fmt"
+	"github.com/therecipe/qt/core"
+	"io/ioutil"
+	"os"
+	"sync"
+)
+
+var (
+	mutex sync.Mutex
+	// rccFiles lists the .rcc files registered but not removed yet.
+	rccFiles []string
+	// initErr is the first error of Init.
+	initErr error
+)
+
+// Register registers files with the Qt resource system under ":/".
+//
+// The resource data is handed to Qt through a temporary .rcc file, not with
+// QResource::registerResource(const uchar *): Qt keeps the pointer to the data
+// it is given, but the binding passes a copy it frees when the call returns.
+// Qt maps or reads the whole file on registration, so it is removed right
+// away where the platform allows it. Where it does not, e.g. on Windows, where
+// a mapped file cannot be removed, the file is removed by Cleanup.
+func Register(files []*File) error {
+	data := Encode(files, qtLocale)
+
+	f, err := ioutil.TempFile("", "goqtuic-*.rcc")
+	if err != nil {
+		return fmt.Errorf("rcc: cannot write resource data: %v", err)
+	}
+	_, err = f.Write(data)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(f.Name())
+		return fmt.Errorf("rcc: cannot write resource data: %v", err)
+	}
+
+	if !core.QResource_RegisterResource(f.Name(), "") {
+		os.Remove(f.Name())
+		return errors.New("rcc: cannot register resource data")
+	}
+	if os.Remove(f.Name()) != nil {
+		mutex.Lock()
+		defer mutex.Unlock()
+		rccFiles = append(rccFiles, f.Name())
+	}
+	return nil
+}
+
+// Init is Register for the init functions of generated resource files, which
+// cannot return an error. The error is written to standard error, and the
+// first one is returned by Err.
+func Init(files []*File) {
+	if err := Register(files); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		mutex.Lock()
+		defer mutex.Unlock()
+		if initErr == nil {
+			initErr = err
+		}
+	}
+}
+
+// Err returns the first error of registering the resources of generated
+// resource files, or nil.
+func Err() error {
+	mutex.Lock()
+	defer mutex.Unlock()
+	return initErr
+}
+
+// Cleanup unregisters the resources whose .rcc files could not be removed on
+// registration and removes the files. Programs using resources should call it
+// before they exit, e.g. deferred in main, after the resources are no longer
+// used.
+func Cleanup() {
+	mutex.Lock()
+	defer mutex.Unlock()
+	for _, file := range rccFiles {
+		core.QResource_UnregisterResource(file, "")
+		os.Remove(file)
+	}
+	rccFiles = nil
+}
+
+func qtLocale(lang string) (int, int) {
+	locale := core.NewQLocale2(lang)
+	return int(locale.Language()), int(locale.Country())
+}